@@ -33,6 +33,24 @@ type Allocation struct {
 
 	// Range of frequency that this Allocation covers
 	Range Range
+
+	// Metadata is optional, dataset specific information about this
+	// Allocation, such as a channel number or a power limit. Packages that
+	// return Allocations document the concrete type stored here, and offer
+	// a typed accessor to get at it, rather than requiring callers to type
+	// assert Metadata.
+	//
+	// Metadata must be comparable, so that Allocations stay comparable with
+	// == and usable as map keys. Packages with Metadata that isn't
+	// comparable, such as a struct containing a slice, store a pointer to
+	// it instead.
+	Metadata interface{}
+}
+
+// Equal will return true if the two Allocations have the same Name and
+// Range. Metadata is not compared.
+func (r Allocation) Equal(r1 Allocation) bool {
+	return r.Name == r1.Name && r.Range.Equal(r1.Range)
 }
 
 // String will output a human readable string representing the Allocation of
@@ -0,0 +1,194 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package aviation
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+// Spacing is the channel spacing in use.
+type Spacing rf.Hz
+
+var (
+	// Spacing25kHz is the legacy 25kHz channel spacing.
+	Spacing25kHz = Spacing(rf.KHz * 25)
+
+	// Spacing8_33kHz is the 8.33kHz channel spacing, where each 25kHz
+	// channel is split into three.
+	Spacing8_33kHz = Spacing(rf.KHz * 25 / 3)
+
+	// Band is the Aeronautical Mobile VHF band.
+	Band = rf.Allocation{
+		Name:  "Aeronautical VHF",
+		Range: rf.Range{rf.KHz * 117975, rf.KHz * 137000},
+	}
+
+	// ErrUnknownChannel will be returned when a channel name or frequency
+	// does not map to a valid Aeronautical VHF channel.
+	ErrUnknownChannel = fmt.Errorf("aviation: unknown channel")
+)
+
+const (
+	// firstBlock is the lowest 25kHz channel in the band, in kHz.
+	firstBlock = 118000
+
+	// lastBlock is the highest 25kHz channel in the band, in kHz.
+	lastBlock = 136975
+)
+
+// Channel is a single Aeronautical VHF channel.
+type Channel struct {
+	// Name is the channel name, in kHz, as it would be dialed into a radio.
+	// For 25kHz channels this is the same as the Frequency, for 8.33kHz
+	// channels this is only a label.
+	Name int
+
+	// Frequency is the actual center frequency of the channel.
+	Frequency rf.Hz
+
+	// Spacing is the channel spacing this channel is using.
+	Spacing Spacing
+}
+
+// String will return the channel name, such as "118.005".
+func (c Channel) String() string {
+	return fmt.Sprintf("%d.%03d", c.Name/1000, c.Name%1000)
+}
+
+// Range will return the range of frequencies occupied by this channel.
+func (c Channel) Range() rf.Range {
+	half := rf.Hz(c.Spacing) / 2
+	return rf.Range{-half, half}.Add(c.Frequency)
+}
+
+// Allocation will return the channel as an rf.Allocation, named after the
+// channel name.
+func (c Channel) Allocation() rf.Allocation {
+	return rf.Allocation{Name: c.String(), Range: c.Range()}
+}
+
+// NewChannel will create a Channel given the channel name in kHz, such as
+// 118005. Channels whose name is a multiple of 25kHz use 25kHz spacing,
+// names ending in 5, 10 or 15 (modulo 25kHz) use 8.33kHz spacing.
+func NewChannel(name int) (Channel, error) {
+	block := name - name%25
+	sub := name % 25
+
+	c := Channel{Name: name}
+	switch sub {
+	case 0:
+		c.Frequency = rf.KHz * rf.Hz(block)
+		c.Spacing = Spacing25kHz
+	case 5, 10, 15:
+		c.Frequency = rf.KHz*rf.Hz(block) + rf.Hz(Spacing8_33kHz)*rf.Hz(sub/5-1)
+		c.Spacing = Spacing8_33kHz
+	default:
+		return Channel{}, ErrUnknownChannel
+	}
+
+	if block < firstBlock || block > lastBlock {
+		return Channel{}, ErrUnknownChannel
+	}
+	return c, nil
+}
+
+// ParseChannel will parse a channel name such as "118.005" or "121.5"
+// into a Channel.
+func ParseChannel(name string) (Channel, error) {
+	mhz, err := strconv.ParseFloat(strings.TrimSpace(name), 64)
+	if err != nil {
+		return Channel{}, ErrUnknownChannel
+	}
+	return NewChannel(int(math.Round(mhz * 1000)))
+}
+
+// MustParseChannel will run the name through ParseChannel, and on error,
+// panic.
+func MustParseChannel(name string) Channel {
+	c, err := ParseChannel(name)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Lookup will return the Channel, using the provided channel spacing, whose
+// range contains the provided frequency.
+func Lookup(freq rf.Hz, spacing Spacing) (Channel, error) {
+	var name int
+	switch spacing {
+	case Spacing25kHz:
+		name = int(math.Round(float64(freq/rf.KHz)/25)) * 25
+	case Spacing8_33kHz:
+		idx := int(math.Round(float64(freq / rf.Hz(Spacing8_33kHz))))
+		name = (idx/3)*25 + (idx%3+1)*5
+	default:
+		return Channel{}, ErrUnknownChannel
+	}
+	return NewChannel(name)
+}
+
+// Channels will return every channel in the band using the provided channel
+// spacing.
+func Channels(spacing Spacing) ([]Channel, error) {
+	switch spacing {
+	case Spacing25kHz, Spacing8_33kHz:
+	default:
+		return nil, ErrUnknownChannel
+	}
+
+	ret := []Channel{}
+	for name := firstBlock; name <= lastBlock+15; name += 5 {
+		c, err := NewChannel(name)
+		if err != nil || c.Spacing != spacing {
+			continue
+		}
+		ret = append(ret, c)
+	}
+	return ret, nil
+}
+
+// Allocations will return every channel in the band using the provided
+// channel spacing as an rf.Allocations.
+func Allocations(spacing Spacing) (rf.Allocations, error) {
+	channels, err := Channels(spacing)
+	if err != nil {
+		return nil, err
+	}
+	ret := rf.Allocations{}
+	for _, c := range channels {
+		ret = append(ret, c.Allocation())
+	}
+	return ret, nil
+}
+
+var (
+	// Emergency is the international aeronautical emergency frequency,
+	// 121.5MHz.
+	Emergency = MustParseChannel("121.500")
+)
+
+// vim: foldmethod=marker
@@ -0,0 +1,78 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package aviation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/aviation"
+)
+
+func TestParseChannel(t *testing.T) {
+	for name, freq := range map[string]rf.Hz{
+		"118.000": rf.KHz * 118000,
+		"118.005": rf.KHz * 118000,
+		"118.010": rf.KHz*118000 + rf.KHz*25/3,
+		"118.015": rf.KHz*118000 + rf.KHz*50/3,
+		"118.025": rf.KHz * 118025,
+		"118.030": rf.KHz * 118025,
+		"121.5":   rf.KHz * 121500,
+	} {
+		c, err := aviation.ParseChannel(name)
+		assert.NoError(t, err, name)
+		assert.InDelta(t, float64(freq), float64(c.Frequency), 0.001, name)
+	}
+
+	for _, name := range []string{"118.020", "118.045", "108.000", "137.000", "abc"} {
+		_, err := aviation.ParseChannel(name)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	c, err := aviation.Lookup(rf.MustParseHz("118.00833MHz"), aviation.Spacing8_33kHz)
+	assert.NoError(t, err)
+	assert.Equal(t, "118.010", c.String())
+
+	c, err = aviation.Lookup(rf.KHz*118000, aviation.Spacing8_33kHz)
+	assert.NoError(t, err)
+	assert.Equal(t, "118.005", c.String())
+
+	c, err = aviation.Lookup(rf.KHz*118000, aviation.Spacing25kHz)
+	assert.NoError(t, err)
+	assert.Equal(t, "118.000", c.String())
+}
+
+func TestChannels(t *testing.T) {
+	channels, err := aviation.Channels(aviation.Spacing25kHz)
+	assert.NoError(t, err)
+	assert.Equal(t, 760, len(channels))
+
+	channels, err = aviation.Channels(aviation.Spacing8_33kHz)
+	assert.NoError(t, err)
+	assert.Equal(t, 2280, len(channels))
+	assert.Equal(t, "136.990", channels[len(channels)-1].String())
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,158 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package rf
+
+import (
+	"math"
+)
+
+// SpectrumAxis describes the frequency axis of an FFT, and converts between
+// frequencies and FFT bins.
+//
+// Bin k is centered on its frequency, and covers half a BinWidth either
+// side of it. The bin at DC (the Center frequency) is bin 0 in natural FFT
+// order, or bin Size/2 (rounded down) when the bins are fftshifted, which
+// matches numpy's fftfreq and fftshift.
+type SpectrumAxis struct {
+	// Center is the frequency at DC.
+	Center Hz
+
+	// SampleRate of the complex samples the FFT was computed from.
+	SampleRate Hz
+
+	// Size is the number of FFT bins.
+	Size int
+
+	// Shifted is true if the bins are in frequency order (fftshifted),
+	// rather than natural FFT order.
+	Shifted bool
+}
+
+// BinWidth will return the frequency spacing of the FFT bins.
+func (a SpectrumAxis) BinWidth() Hz {
+	return a.SampleRate / Hz(a.Size)
+}
+
+// dc will return the index of the DC bin once shifted.
+func (a SpectrumAxis) dc() int {
+	return a.Size / 2
+}
+
+// index will convert a bin index in frequency order to the bin index on
+// this axis.
+func (a SpectrumAxis) index(ordered int) int {
+	if a.Shifted {
+		return ordered
+	}
+	return ((ordered-a.dc())%a.Size + a.Size) % a.Size
+}
+
+// ordered will convert a (possibly fractional) bin on this axis to its
+// position in frequency order. Bins outside of the axis are already in
+// frequency order, matching what Bin returns for frequencies outside the
+// Range.
+func (a SpectrumAxis) ordered(bin float64) float64 {
+	if a.Shifted || bin < -0.5 || bin >= float64(a.Size)-0.5 {
+		return bin
+	}
+	if bin >= float64((a.Size+1)/2)-0.5 {
+		bin -= float64(a.Size)
+	}
+	return bin + float64(a.dc())
+}
+
+// Frequency will return the center frequency of the (possibly fractional)
+// bin.
+func (a SpectrumAxis) Frequency(bin float64) Hz {
+	return a.Center + Hz(a.ordered(bin)-float64(a.dc()))*a.BinWidth()
+}
+
+// Bin will return the (possibly fractional) bin the frequency falls on,
+// where a whole number is the center of a bin. Frequencies outside the
+// Range will return bins that round to outside of 0 to Size-1, in
+// frequency order -- below 0 for frequencies below the Range, and above
+// Size-1 for frequencies above it.
+func (a SpectrumAxis) Bin(freq Hz) float64 {
+	ordered := float64((freq-a.Center)/a.BinWidth()) + float64(a.dc())
+	if a.Shifted || ordered < -0.5 || ordered >= float64(a.Size)-0.5 {
+		return ordered
+	}
+	offset := ordered - float64(a.dc())
+	if offset < -0.5 {
+		offset += float64(a.Size)
+	}
+	return offset
+}
+
+// BinRange will return the range of frequencies covered by the bin.
+func (a SpectrumAxis) BinRange(bin int) Range {
+	half := a.BinWidth() / 2
+	f := a.Frequency(float64(bin))
+	return Range{f - half, f + half}
+}
+
+// Range will return the range of frequencies covered by every bin, from
+// the lower edge of the lowest bin to the upper edge of the highest.
+func (a SpectrumAxis) Range() Range {
+	return Range{
+		a.BinRange(a.index(0))[0],
+		a.BinRange(a.index(a.Size - 1))[1],
+	}
+}
+
+// Frequencies will return the center frequency of every bin, in bin order.
+func (a SpectrumAxis) Frequencies() []Hz {
+	ret := make([]Hz, a.Size)
+	for i := range ret {
+		ret[i] = a.Frequency(float64(i))
+	}
+	return ret
+}
+
+// Bins will return the indexes of every bin that overlaps the Range, in
+// frequency order. A Range that falls exactly on the edge between two bins
+// will only include the upper bin at its start, and the lower bin at its
+// end, so adjacent Ranges never share a bin.
+func (a SpectrumAxis) Bins(r Range) []int {
+	// Work in frequency order, where the DC bin is at dc.
+	offset := func(freq Hz) float64 {
+		return float64((freq-a.Center)/a.BinWidth()) + float64(a.dc())
+	}
+	lo := int(math.Floor(offset(r[0]) + 0.5))
+	hi := int(math.Ceil(offset(r[1])+0.5)) - 1
+	if hi < lo {
+		hi = lo
+	}
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > a.Size-1 {
+		hi = a.Size - 1
+	}
+
+	ret := []int{}
+	for i := lo; i <= hi; i++ {
+		ret = append(ret, a.index(i))
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,111 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package rf_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+)
+
+func TestSpectrumAxisShifted(t *testing.T) {
+	axis := rf.SpectrumAxis{
+		Center:     rf.MHz * 100,
+		SampleRate: rf.MHz * 1,
+		Size:       8,
+		Shifted:    true,
+	}
+	assert.Equal(t, rf.KHz*125, axis.BinWidth())
+	assert.Equal(t, rf.KHz*99500, axis.Frequency(0))
+	assert.Equal(t, rf.MHz*100, axis.Frequency(4))
+	assert.Equal(t, rf.KHz*100375, axis.Frequency(7))
+	assert.Equal(t, 4.0, axis.Bin(rf.MHz*100))
+	assert.Equal(t, 4.5, axis.Bin(rf.KHz*100062.5))
+	assert.Equal(t, rf.Range{rf.KHz * 99437.5, rf.KHz * 100437.5}, axis.Range())
+
+	assert.Equal(t, []int{4, 5}, axis.Bins(rf.Range{rf.MHz * 100, rf.KHz * 100125}))
+	// Adjacent Ranges split on a bin edge don't share a bin.
+	assert.Equal(t, []int{4}, axis.Bins(rf.Range{rf.KHz * 99937.5, rf.KHz * 100062.5}))
+	assert.Equal(t, []int{5}, axis.Bins(rf.Range{rf.KHz * 100062.5, rf.KHz * 100187.5}))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, axis.Bins(rf.Range{rf.MHz * 90, rf.MHz * 110}))
+	assert.Equal(t, []int{7}, axis.Bins(rf.Range{rf.KHz * 100375, rf.KHz * 100375}))
+}
+
+func TestSpectrumAxisNatural(t *testing.T) {
+	axis := rf.SpectrumAxis{Center: 0, SampleRate: 8, Size: 8}
+	assert.Equal(t,
+		[]rf.Hz{0, 1, 2, 3, -4, -3, -2, -1},
+		axis.Frequencies(),
+	)
+	assert.Equal(t, 7.0, axis.Bin(-1))
+	assert.Equal(t, 3.0, axis.Bin(3))
+	assert.Equal(t, []int{6, 7, 0, 1}, axis.Bins(rf.Range{-2, 1}))
+	assert.Equal(t, rf.Range{-4.5, 3.5}, axis.Range())
+
+	odd := rf.SpectrumAxis{Center: 0, SampleRate: 5, Size: 5}
+	assert.Equal(t, []rf.Hz{0, 1, 2, -2, -1}, odd.Frequencies())
+	odd.Shifted = true
+	assert.Equal(t, []rf.Hz{-2, -1, 0, 1, 2}, odd.Frequencies())
+	assert.Equal(t, 2.0, odd.Bin(0))
+}
+
+func TestSpectrumAxisOutOfRange(t *testing.T) {
+	axis := rf.SpectrumAxis{Center: 0, SampleRate: 8, Size: 8}
+
+	// Below the Range, which starts at -4.5.
+	assert.Equal(t, -1.0, axis.Bin(-5))
+	assert.Equal(t, -2.0, axis.Bin(-6))
+	// Above the Range, which ends at 3.5.
+	assert.Equal(t, 8.0, axis.Bin(4))
+
+	// Just inside either edge of the Range, and either side of DC.
+	assert.Equal(t, 3.75, axis.Bin(-4.25))
+	assert.Equal(t, 3.25, axis.Bin(3.25))
+	assert.Equal(t, -0.25, axis.Bin(-0.25))
+
+	assert.Equal(t, rf.Hz(-5), axis.Frequency(-1))
+	assert.Equal(t, rf.Hz(4), axis.Frequency(8))
+
+	freqs := []rf.Hz{-20, -6, -5, -4.75, -4.25, -3, -0.25, 0, 0.25, 3.25, 3.5, 4, 20}
+	for _, freq := range freqs {
+		assert.InDelta(t, float64(freq), float64(axis.Frequency(axis.Bin(freq))), 1e-9, "%s", freq)
+	}
+
+	axis.Shifted = true
+	assert.Equal(t, -1.0, axis.Bin(-5))
+	assert.Equal(t, 8.0, axis.Bin(4))
+	for _, freq := range freqs {
+		assert.InDelta(t, float64(freq), float64(axis.Frequency(axis.Bin(freq))), 1e-9, "%s", freq)
+	}
+
+	// Odd sizes have DC in the middle bin.
+	axis = rf.SpectrumAxis{Center: 100, SampleRate: 7, Size: 7}
+	for _, shifted := range []bool{false, true} {
+		axis.Shifted = shifted
+		for _, freq := range []rf.Hz{80, 96, 96.5, 97, 100, 103, 103.5, 104, 120} {
+			assert.InDelta(t, float64(freq), float64(axis.Frequency(axis.Bin(freq))), 1e-9, "%s", freq)
+		}
+	}
+}
+
+// vim: foldmethod=marker
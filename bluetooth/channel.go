@@ -0,0 +1,170 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package bluetooth
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+var (
+	// ErrInvalidChannel will be returned when a channel index is out of
+	// range.
+	ErrInvalidChannel = fmt.Errorf("bluetooth: invalid channel")
+
+	// Band is the 2.4GHz ISM band that Bluetooth operates in.
+	Band = rf.Allocation{
+		Name:  "Bluetooth",
+		Range: rf.Range{rf.MHz * 2400, rf.KHz * 2483500},
+	}
+)
+
+// BREDRChannel is a Bluetooth BR/EDR (Classic) channel, from 0 to 78.
+type BREDRChannel int
+
+// Valid will return true if the channel is between 0 and 78.
+func (c BREDRChannel) Valid() bool {
+	return c >= 0 && c <= 78
+}
+
+// Frequency will return the center frequency of the channel.
+func (c BREDRChannel) Frequency() rf.Hz {
+	return rf.MHz * rf.Hz(2402+int(c))
+}
+
+// Range will return the 1MHz range the channel occupies.
+func (c BREDRChannel) Range() rf.Range {
+	return rf.Range{-rf.KHz * 500, rf.KHz * 500}.Add(c.Frequency())
+}
+
+// BREDRChannels are all 79 Bluetooth BR/EDR channels as rf.Allocations,
+// with the BREDRChannel set as the Metadata.
+var BREDRChannels = func() rf.Allocations {
+	ret := rf.Allocations{}
+	for c := BREDRChannel(0); c.Valid(); c++ {
+		ret = append(ret, rf.Allocation{
+			Name:     fmt.Sprintf("BR/EDR %d", c),
+			Range:    c.Range(),
+			Metadata: c,
+		})
+	}
+	return ret
+}()
+
+// BREDRChannelOf will return the BREDRChannel of an rf.Allocation from
+// BREDRChannels, and false if the rf.Allocation doesn't have one.
+func BREDRChannelOf(a rf.Allocation) (BREDRChannel, bool) {
+	c, ok := a.Metadata.(BREDRChannel)
+	return c, ok
+}
+
+// LEChannel is a Bluetooth Low Energy channel index, from 0 to 39. Indexes
+// 0 through 36 are data channels, and 37, 38 and 39 are the primary
+// advertising channels.
+//
+// The channel index is not in frequency order, the advertising channels are
+// placed at RF channel 0, 12 and 39 (2402MHz, 2426MHz and 2480MHz) to avoid
+// the most commonly used WiFi channels.
+type LEChannel int
+
+const (
+	// LEAdvertising37 is the first primary advertising channel, at 2402MHz.
+	LEAdvertising37 LEChannel = 37
+
+	// LEAdvertising38 is the second primary advertising channel, at 2426MHz.
+	LEAdvertising38 LEChannel = 38
+
+	// LEAdvertising39 is the third primary advertising channel, at 2480MHz.
+	LEAdvertising39 LEChannel = 39
+)
+
+// Valid will return true if the channel index is between 0 and 39.
+func (c LEChannel) Valid() bool {
+	return c >= 0 && c <= 39
+}
+
+// Advertising will return true if this is one of the primary advertising
+// channels.
+func (c LEChannel) Advertising() bool {
+	return c >= LEAdvertising37 && c <= LEAdvertising39
+}
+
+// RFChannel will return the physical channel number, in frequency order,
+// where 0 is 2402MHz and 39 is 2480MHz.
+func (c LEChannel) RFChannel() int {
+	switch {
+	case c == LEAdvertising37:
+		return 0
+	case c == LEAdvertising38:
+		return 12
+	case c == LEAdvertising39:
+		return 39
+	case c <= 10:
+		return int(c) + 1
+	default:
+		return int(c) + 2
+	}
+}
+
+// Frequency will return the center frequency of the channel.
+func (c LEChannel) Frequency() rf.Hz {
+	return rf.MHz * rf.Hz(2402+2*c.RFChannel())
+}
+
+// Range will return the 2MHz range the channel occupies.
+func (c LEChannel) Range() rf.Range {
+	return rf.Range{-rf.MHz, rf.MHz}.Add(c.Frequency())
+}
+
+// LEChannelForFrequency will return the channel index whose Range contains
+// the provided frequency.
+func LEChannelForFrequency(freq rf.Hz) (LEChannel, error) {
+	for c := LEChannel(0); c.Valid(); c++ {
+		if c.Range().ContainsFrequency(freq) {
+			return c, nil
+		}
+	}
+	return 0, ErrInvalidChannel
+}
+
+// LEChannels are all 40 Bluetooth Low Energy channels as rf.Allocations,
+// ordered by channel index, with the LEChannel set as the Metadata.
+var LEChannels = func() rf.Allocations {
+	ret := rf.Allocations{}
+	for c := LEChannel(0); c.Valid(); c++ {
+		ret = append(ret, rf.Allocation{
+			Name:     fmt.Sprintf("BLE %d", c),
+			Range:    c.Range(),
+			Metadata: c,
+		})
+	}
+	return ret
+}()
+
+// LEChannelOf will return the LEChannel of an rf.Allocation from LEChannels,
+// and false if the rf.Allocation doesn't have one.
+func LEChannelOf(a rf.Allocation) (LEChannel, bool) {
+	c, ok := a.Metadata.(LEChannel)
+	return c, ok
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,110 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package bluetooth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/bluetooth"
+)
+
+func TestLEChannels(t *testing.T) {
+	assert.Equal(t, rf.MHz*2402, bluetooth.LEAdvertising37.Frequency())
+	assert.Equal(t, rf.MHz*2426, bluetooth.LEAdvertising38.Frequency())
+	assert.Equal(t, rf.MHz*2480, bluetooth.LEAdvertising39.Frequency())
+	assert.Equal(t, rf.MHz*2404, bluetooth.LEChannel(0).Frequency())
+	assert.Equal(t, rf.MHz*2424, bluetooth.LEChannel(10).Frequency())
+	assert.Equal(t, rf.MHz*2428, bluetooth.LEChannel(11).Frequency())
+	assert.Equal(t, rf.MHz*2478, bluetooth.LEChannel(36).Frequency())
+
+	c, err := bluetooth.LEChannelForFrequency(rf.MHz * 2426)
+	assert.NoError(t, err)
+	assert.Equal(t, bluetooth.LEAdvertising38, c)
+
+	assert.Equal(t, 40, len(bluetooth.LEChannels))
+	assert.Equal(t, 79, len(bluetooth.BREDRChannels))
+	assert.Equal(t, rf.MHz*2480, bluetooth.BREDRChannel(78).Frequency())
+
+	le, ok := bluetooth.LEChannelOf(bluetooth.LEChannels[38])
+	assert.True(t, ok)
+	assert.Equal(t, bluetooth.LEAdvertising38, le)
+	bredr, ok := bluetooth.BREDRChannelOf(bluetooth.BREDRChannels[78])
+	assert.True(t, ok)
+	assert.Equal(t, bluetooth.BREDRChannel(78), bredr)
+	_, ok = bluetooth.LEChannelOf(bluetooth.BREDRChannels[0])
+	assert.False(t, ok)
+}
+
+func TestCSA1(t *testing.T) {
+	csa := bluetooth.CSA1{HopIncrement: 7, ChannelMap: bluetooth.AllDataChannels}
+	assert.Equal(t, bluetooth.LEChannel(7), csa.Channel(0))
+	assert.Equal(t, bluetooth.LEChannel(14), csa.Channel(1))
+	assert.Equal(t, bluetooth.LEChannel(5), csa.Channel(5))
+
+	csa.ChannelMap = bluetooth.NewChannelMap(1, 2, 3)
+	// Unmapped channel 7 is not in use, 7 % 3 = 1, so the second used
+	// channel is selected.
+	assert.Equal(t, bluetooth.LEChannel(2), csa.Channel(0))
+
+	// An empty ChannelMap returns the unmapped channel, rather than
+	// panicking.
+	assert.Equal(t, bluetooth.LEChannel(7), bluetooth.CSA1{HopIncrement: 7}.Channel(0))
+
+	csa, err := bluetooth.NewCSA1(7, bluetooth.AllDataChannels)
+	assert.NoError(t, err)
+	assert.Equal(t, bluetooth.LEChannel(7), csa.Channel(0))
+	_, err = bluetooth.NewCSA1(4, bluetooth.AllDataChannels)
+	assert.Error(t, err)
+	_, err = bluetooth.NewCSA1(7, bluetooth.NewChannelMap(1))
+	assert.Error(t, err)
+}
+
+func TestCSA2(t *testing.T) {
+	// Sample data from the Bluetooth Core Specification, Vol 6, Part C,
+	// Section 3.
+	csa := bluetooth.CSA2{AccessAddress: 0x8E89BED6, ChannelMap: bluetooth.AllDataChannels}
+	assert.Equal(t, uint16(0x305F), csa.ChannelIdentifier())
+	assert.Equal(t, []bluetooth.LEChannel{25, 20, 6, 21}, []bluetooth.LEChannel{
+		csa.Channel(0), csa.Channel(1), csa.Channel(2), csa.Channel(3),
+	})
+
+	csa.ChannelMap = bluetooth.NewChannelMap(9, 10, 21, 22, 23, 33, 34, 35, 36)
+	assert.Equal(t, []bluetooth.LEChannel{23, 9, 34}, []bluetooth.LEChannel{
+		csa.Channel(6), csa.Channel(7), csa.Channel(8),
+	})
+
+	assert.Equal(t, []rf.Hz{rf.MHz * 2452, rf.MHz * 2422, rf.MHz * 2474},
+		bluetooth.HopSequence(csa, 6, 3))
+
+	empty := bluetooth.CSA2{AccessAddress: 0x8E89BED6}
+	assert.Equal(t, bluetooth.LEChannel(25), empty.Channel(0))
+
+	csa, err := bluetooth.NewCSA2(0x8E89BED6, bluetooth.AllDataChannels)
+	assert.NoError(t, err)
+	assert.Equal(t, bluetooth.LEChannel(25), csa.Channel(0))
+	_, err = bluetooth.NewCSA2(0x8E89BED6, 0)
+	assert.Error(t, err)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,184 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package bluetooth
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+// ChannelMap is a bitmask of Bluetooth Low Energy data channels in use,
+// where bit n is set if data channel n is used. Only bits 0 through 36 are
+// meaningful.
+type ChannelMap uint64
+
+// AllDataChannels is a ChannelMap with all 37 data channels in use.
+const AllDataChannels ChannelMap = 1<<37 - 1
+
+// NewChannelMap will create a ChannelMap with the provided data channels
+// marked as used.
+func NewChannelMap(channels ...LEChannel) ChannelMap {
+	var m ChannelMap
+	for _, c := range channels {
+		m |= 1 << uint(c)
+	}
+	return m & AllDataChannels
+}
+
+// Used will return true if the provided data channel is in use.
+func (m ChannelMap) Used(c LEChannel) bool {
+	return c >= 0 && c < LEAdvertising37 && m&(1<<uint(c)) != 0
+}
+
+// Channels will return all the used data channels, in ascending order.
+func (m ChannelMap) Channels() []LEChannel {
+	ret := []LEChannel{}
+	for c := LEChannel(0); c < LEAdvertising37; c++ {
+		if m.Used(c) {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// Validate will ensure that at least two data channels are in use, as
+// required by the Bluetooth Core Specification.
+func (m ChannelMap) Validate() error {
+	if len(m.Channels()) < 2 {
+		return fmt.Errorf("bluetooth: channel map must have at least 2 channels")
+	}
+	return nil
+}
+
+// remap will map the unmapped channel onto a used channel, given a function
+// to compute the remapping index from the number of used channels. An
+// empty ChannelMap has nothing to remap onto, so the unmapped channel is
+// returned as is.
+func (m ChannelMap) remap(unmapped LEChannel, index func(used int) int) LEChannel {
+	channels := m.Channels()
+	if m.Used(unmapped) || len(channels) == 0 {
+		return unmapped
+	}
+	return channels[index(len(channels))]
+}
+
+// ChannelSelection is an algorithm to select the data channel used by a
+// connection event.
+type ChannelSelection interface {
+	// Channel will return the data channel used by the connection event
+	// with the provided event counter.
+	Channel(event uint16) LEChannel
+}
+
+// CSA1 is the Bluetooth Low Energy Channel Selection Algorithm #1, which
+// hops through the data channels by a fixed increment.
+type CSA1 struct {
+	// HopIncrement is the hop increment, between 5 and 16.
+	HopIncrement int
+
+	// ChannelMap is the set of used data channels.
+	ChannelMap ChannelMap
+}
+
+// NewCSA1 will create a CSA1, ensuring the hop increment is between 5 and
+// 16, and the ChannelMap is valid.
+func NewCSA1(hopIncrement int, channelMap ChannelMap) (CSA1, error) {
+	if hopIncrement < 5 || hopIncrement > 16 {
+		return CSA1{}, fmt.Errorf("bluetooth: hop increment must be between 5 and 16")
+	}
+	if err := channelMap.Validate(); err != nil {
+		return CSA1{}, err
+	}
+	return CSA1{HopIncrement: hopIncrement, ChannelMap: channelMap}, nil
+}
+
+// Channel implements the ChannelSelection interface. If the ChannelMap is
+// empty, the unmapped channel is returned.
+func (c CSA1) Channel(event uint16) LEChannel {
+	unmapped := LEChannel(((int(event) + 1) * c.HopIncrement) % 37)
+	return c.ChannelMap.remap(unmapped, func(used int) int {
+		return int(unmapped) % used
+	})
+}
+
+// CSA2 is the Bluetooth Low Energy Channel Selection Algorithm #2, which
+// selects data channels based on a pseudo-random number derived from the
+// Access Address and connection event counter.
+type CSA2 struct {
+	// AccessAddress of the connection.
+	AccessAddress uint32
+
+	// ChannelMap is the set of used data channels.
+	ChannelMap ChannelMap
+}
+
+// NewCSA2 will create a CSA2, ensuring the ChannelMap is valid.
+func NewCSA2(accessAddress uint32, channelMap ChannelMap) (CSA2, error) {
+	if err := channelMap.Validate(); err != nil {
+		return CSA2{}, err
+	}
+	return CSA2{AccessAddress: accessAddress, ChannelMap: channelMap}, nil
+}
+
+// ChannelIdentifier will return the channel identifier, derived from the
+// Access Address.
+func (c CSA2) ChannelIdentifier() uint16 {
+	return uint16(c.AccessAddress>>16) ^ uint16(c.AccessAddress)
+}
+
+// perm will reverse the bit order of each byte of v.
+func perm(v uint16) uint16 {
+	var ret uint16
+	for i := uint(0); i < 8; i++ {
+		ret |= ((v >> i) & 0x0101) << (7 - i)
+	}
+	return ret
+}
+
+// Channel implements the ChannelSelection interface. If the ChannelMap is
+// empty, the unmapped channel is returned.
+func (c CSA2) Channel(event uint16) LEChannel {
+	id := c.ChannelIdentifier()
+	prn := event ^ id
+	for i := 0; i < 3; i++ {
+		prn = perm(prn)
+		prn = 17*prn + id
+	}
+	prn ^= id
+
+	unmapped := LEChannel(prn % 37)
+	return c.ChannelMap.remap(unmapped, func(used int) int {
+		return (used * int(prn)) >> 16
+	})
+}
+
+// HopSequence will return the frequencies used by count connection events,
+// starting at the provided event counter.
+func HopSequence(selection ChannelSelection, event uint16, count int) []rf.Hz {
+	ret := make([]rf.Hz, count)
+	for i := range ret {
+		ret[i] = selection.Channel(event + uint16(i)).Frequency()
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
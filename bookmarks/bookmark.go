@@ -0,0 +1,145 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package bookmarks
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+// ErrInvalidFile will be returned when a bookmark or band plan file is not
+// well formed.
+var ErrInvalidFile = fmt.Errorf("bookmarks: invalid file")
+
+// Mode is a demodulator mode. Each application names its modes
+// differently; a Mode that isn't one of the constants below is passed
+// through unchanged.
+type Mode string
+
+const (
+	// NFM is narrowband FM.
+	NFM Mode = "NFM"
+
+	// WFM is wideband (broadcast) FM.
+	WFM Mode = "WFM"
+
+	// AM is amplitude modulation.
+	AM Mode = "AM"
+
+	// DSB is double sideband, suppressed carrier.
+	DSB Mode = "DSB"
+
+	// USB is upper sideband.
+	USB Mode = "USB"
+
+	// LSB is lower sideband.
+	LSB Mode = "LSB"
+
+	// CW is continuous wave (Morse).
+	CW Mode = "CW"
+
+	// Raw is raw IQ, without demodulation.
+	Raw Mode = "RAW"
+)
+
+// Metadata is the Metadata of every rf.Allocation read by this package,
+// stored as a *Metadata so the rf.Allocation stays comparable.
+type Metadata struct {
+	// Mode to demodulate with.
+	Mode Mode
+
+	// Bandwidth of the receive filter.
+	Bandwidth rf.Hz
+
+	// Step is the tuning step within a band.
+	Step rf.Hz
+
+	// Color to display the entry in, as "#rrggbb" or "#rrggbbaa".
+	Color string
+
+	// Tags, groups or lists the entry is in. The first tag is used when an
+	// application only supports one.
+	Tags []string
+
+	// Favourite is set for SDR# favourites.
+	Favourite bool
+}
+
+// MetadataOf will return a copy of the Metadata of the Allocation, and false
+// if the Allocation doesn't have any.
+func MetadataOf(a rf.Allocation) (Metadata, bool) {
+	m, ok := a.Metadata.(*Metadata)
+	if !ok || m == nil {
+		return Metadata{}, false
+	}
+	return *m, true
+}
+
+// metadata will return the Metadata of the Allocation, or the zero value if
+// it doesn't have any.
+func metadata(a rf.Allocation) Metadata {
+	m, _ := MetadataOf(a)
+	return m
+}
+
+// tag will return the first tag of the Metadata, or the fallback.
+func (m Metadata) tag(fallback string) string {
+	if len(m.Tags) == 0 || m.Tags[0] == "" {
+		return fallback
+	}
+	return m.Tags[0]
+}
+
+// bookmark will create a single frequency Allocation.
+func bookmark(name string, freq rf.Hz, m Metadata) rf.Allocation {
+	return rf.Allocation{Name: name, Range: rf.Range{freq, freq}, Metadata: &m}
+}
+
+// modeTable maps between Modes and an application's names for them.
+type modeTable []struct {
+	mode Mode
+	name string
+}
+
+// mode will return the Mode for an application's name, using the first
+// match, or the name itself if it's unknown.
+func (t modeTable) mode(name string) Mode {
+	for _, entry := range t {
+		if entry.name == name {
+			return entry.mode
+		}
+	}
+	return Mode(name)
+}
+
+// name will return the application's name for a Mode, using the first
+// match, or the Mode itself if it's unknown.
+func (t modeTable) name(mode Mode) string {
+	for _, entry := range t {
+		if entry.mode == mode {
+			return entry.name
+		}
+	}
+	return string(mode)
+}
+
+// vim: foldmethod=marker
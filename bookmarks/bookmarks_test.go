@@ -0,0 +1,253 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package bookmarks_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/bookmarks"
+)
+
+const gqrxBookmarks = `# Tag name          ;  color
+Marine              ; #00ff00
+Weather             ; #0000ff
+
+# Frequency ; Name                     ; Modulation          ;  Bandwidth; Tags
+   156800000; Channel 16               ; Narrow FM           ;      10000; Marine
+   162550000; WX1                      ; Narrow FM           ;      10000; Weather,Marine
+    14074000; FT8                      ; USB                 ;       2800; 
+`
+
+// metadata will return the bookmarks.Metadata of the Allocation.
+func metadata(a rf.Allocation) bookmarks.Metadata {
+	m, _ := bookmarks.MetadataOf(a)
+	return m
+}
+
+func TestMetadataOf(t *testing.T) {
+	allocations, err := bookmarks.ReadGQRXBookmarks(strings.NewReader(gqrxBookmarks))
+	assert.NoError(t, err)
+
+	m, ok := bookmarks.MetadataOf(allocations[0])
+	assert.True(t, ok)
+	assert.Equal(t, bookmarks.NFM, m.Mode)
+
+	// Allocations stay comparable, and usable as map keys.
+	assert.True(t, allocations[0] == allocations[0])
+	assert.False(t, allocations[0] == allocations[1])
+	seen := map[rf.Allocation]bool{allocations[0]: true}
+	assert.True(t, seen[allocations[0]])
+
+	_, ok = bookmarks.MetadataOf(rf.Allocation{Name: "WWV"})
+	assert.False(t, ok)
+}
+
+func TestGQRXBookmarks(t *testing.T) {
+	allocations, err := bookmarks.ReadGQRXBookmarks(strings.NewReader(gqrxBookmarks))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(allocations))
+
+	assert.Equal(t, "Channel 16", allocations[0].Name)
+	assert.Equal(t, rf.Range{rf.KHz * 156800, rf.KHz * 156800}, allocations[0].Range)
+	assert.Equal(t, bookmarks.Metadata{
+		Mode:      bookmarks.NFM,
+		Bandwidth: rf.KHz * 10,
+		Color:     "#00ff00",
+		Tags:      []string{"Marine"},
+	}, metadata(allocations[0]))
+	assert.Equal(t, "#0000ff", metadata(allocations[1]).Color)
+	assert.Equal(t, bookmarks.USB, metadata(allocations[2]).Mode)
+	assert.Equal(t, []string{}, metadata(allocations[2]).Tags)
+
+	var buf bytes.Buffer
+	assert.NoError(t, bookmarks.WriteGQRXBookmarks(&buf, allocations))
+	assert.Equal(t, gqrxBookmarks, buf.String())
+}
+
+func TestGQRXBandplan(t *testing.T) {
+	const plan = `# Start freq, End freq, Mode, Step, Color, Name
+135700, 137800, CW-U, 100, #6c0000, 2200m
+144000000, 148000000, Narrow FM, 5000, #ff000040, 2m
+`
+	allocations, err := bookmarks.ReadGQRXBandplan(strings.NewReader(plan))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(allocations))
+	assert.Equal(t, "2m", allocations[1].Name)
+	assert.Equal(t, rf.Range{rf.MHz * 144, rf.MHz * 148}, allocations[1].Range)
+	assert.Equal(t, bookmarks.Metadata{
+		Mode:  bookmarks.NFM,
+		Step:  rf.KHz * 5,
+		Color: "#ff000040",
+	}, metadata(allocations[1]))
+
+	var buf bytes.Buffer
+	assert.NoError(t, bookmarks.WriteGQRXBandplan(&buf, allocations))
+	assert.Equal(t, plan, buf.String())
+
+	_, err = bookmarks.ReadGQRXBandplan(strings.NewReader("1, 2, 3\n"))
+	assert.Equal(t, bookmarks.ErrInvalidFile, err)
+}
+
+func TestSDRSharp(t *testing.T) {
+	const frequencies = `<?xml version="1.0"?>
+<ArrayOfMemoryEntry xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+  <MemoryEntry>
+    <IsFavourite>true</IsFavourite>
+    <Name>Channel 16</Name>
+    <GroupName>Marine</GroupName>
+    <Frequency>156800000</Frequency>
+    <DetectorType>NFM</DetectorType>
+    <Shift>0</Shift>
+    <FilterBandwidth>12500</FilterBandwidth>
+  </MemoryEntry>
+  <MemoryEntry>
+    <IsFavourite>false</IsFavourite>
+    <Name>WWV</Name>
+    <GroupName></GroupName>
+    <Frequency>10000000</Frequency>
+    <DetectorType>AM</DetectorType>
+    <Shift>0</Shift>
+    <FilterBandwidth>6000</FilterBandwidth>
+  </MemoryEntry>
+</ArrayOfMemoryEntry>`
+
+	allocations, err := bookmarks.ReadSDRSharp(strings.NewReader(frequencies))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(allocations))
+	assert.Equal(t, bookmarks.Metadata{
+		Mode:      bookmarks.NFM,
+		Bandwidth: rf.KHz * 12.5,
+		Tags:      []string{"Marine"},
+		Favourite: true,
+	}, metadata(allocations[0]))
+	assert.Equal(t, rf.MHz*10, allocations[1].Range[0])
+
+	var buf bytes.Buffer
+	assert.NoError(t, bookmarks.WriteSDRSharp(&buf, allocations))
+	again, err := bookmarks.ReadSDRSharp(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, allocations, again)
+}
+
+func TestSDRPPBookmarks(t *testing.T) {
+	const config = `{
+    "bookmarkDisplayMode": 1,
+    "lists": {
+        "General": {
+            "bookmarks": {
+                "WWV": {"bandwidth": 6000.0, "frequency": 10000000.0, "mode": 2}
+            },
+            "showOnWaterfall": true
+        },
+        "Marine": {
+            "bookmarks": {
+                "Channel 16": {"bandwidth": 12500.0, "frequency": 156800000.0, "mode": 0},
+                "Channel 13": {"bandwidth": 12500.0, "frequency": 156650000.0, "mode": 0}
+            },
+            "showOnWaterfall": false
+        }
+    },
+    "selectedList": "General"
+}`
+
+	allocations, err := bookmarks.ReadSDRPPBookmarks(strings.NewReader(config))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(allocations))
+	assert.Equal(t, "WWV", allocations[0].Name)
+	assert.Equal(t, bookmarks.AM, metadata(allocations[0]).Mode)
+	assert.Equal(t, "Channel 13", allocations[1].Name)
+	assert.Equal(t, bookmarks.Metadata{
+		Mode:      bookmarks.NFM,
+		Bandwidth: rf.KHz * 12.5,
+		Tags:      []string{"Marine"},
+	}, metadata(allocations[2]))
+
+	var buf bytes.Buffer
+	assert.NoError(t, bookmarks.WriteSDRPPBookmarks(&buf, allocations))
+	again, err := bookmarks.ReadSDRPPBookmarks(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, allocations, again)
+}
+
+func TestSDRPPBandPlan(t *testing.T) {
+	const (
+		plan = `{
+    "name": "General",
+    "country_name": "International",
+    "country_code": "--",
+    "author_name": "Example",
+    "author_url": "https://example.com",
+    "bands": [
+        {"name": "Broadcast", "type": "broadcast", "start": 530000, "end": 1700000},
+        {"name": "160m", "type": "amateur", "start": 1800000, "end": 2000000}
+    ]
+}`
+		colors = `{"amateur": "#FF0000FF", "broadcast": "#0000FFFF"}`
+	)
+
+	p, err := bookmarks.ReadSDRPPBandPlan(strings.NewReader(plan), strings.NewReader(colors))
+	assert.NoError(t, err)
+	assert.Equal(t, "International", p.CountryName)
+	assert.Equal(t, 2, len(p.Bands))
+	assert.Equal(t, rf.Range{rf.KHz * 1800, rf.KHz * 2000}, p.Bands[1].Range)
+	assert.Equal(t, bookmarks.Metadata{
+		Color: "#FF0000FF",
+		Tags:  []string{"amateur"},
+	}, metadata(p.Bands[1]))
+
+	var buf bytes.Buffer
+	assert.NoError(t, p.Write(&buf))
+	again, err := bookmarks.ReadSDRPPBandPlan(&buf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, p.Name, again.Name)
+	assert.Equal(t, p.Bands[1].Range, again.Bands[1].Range)
+
+	buf.Reset()
+	assert.NoError(t, p.WriteColors(&buf))
+	assert.JSONEq(t, colors, buf.String())
+}
+
+func TestConvert(t *testing.T) {
+	allocations, err := bookmarks.ReadGQRXBookmarks(strings.NewReader(gqrxBookmarks))
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, bookmarks.WriteSDRSharp(&buf, allocations))
+	sdrsharp, err := bookmarks.ReadSDRSharp(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(sdrsharp))
+	assert.Equal(t, allocations[1].Range, sdrsharp[1].Range)
+	assert.Equal(t, []string{"Weather"}, metadata(sdrsharp[1]).Tags)
+
+	buf.Reset()
+	assert.NoError(t, bookmarks.WriteGQRXBookmarks(&buf, sdrsharp))
+	gqrx, err := bookmarks.ReadGQRXBookmarks(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, bookmarks.USB, metadata(gqrx[2]).Mode)
+	assert.Equal(t, rf.KHz*2.8, metadata(gqrx[2]).Bandwidth)
+}
+
+// vim: foldmethod=marker
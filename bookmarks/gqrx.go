@@ -0,0 +1,234 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package bookmarks
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+// gqrxModes are GQRX's names for each Mode. The first name for a Mode is
+// the one written.
+var gqrxModes = modeTable{
+	{NFM, "Narrow FM"},
+	{WFM, "WFM (stereo)"},
+	{WFM, "WFM (mono)"},
+	{WFM, "WFM (oirt)"},
+	{AM, "AM"},
+	{AM, "AM-Sync"},
+	{USB, "USB"},
+	{LSB, "LSB"},
+	{CW, "CW-U"},
+	{CW, "CW-L"},
+	{Raw, "Raw I/Q"},
+}
+
+// gqrxDefaultColor is the color GQRX gives untagged bookmarks.
+const gqrxDefaultColor = "#c0c0c0"
+
+// gqrxLines will call fn with the fields of every line that isn't blank or
+// a comment.
+func gqrxLines(r io.Reader, sep string, fn func([]string) error) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, sep)
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if err := fn(fields); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// parseHz will parse a whole number of Hz.
+func parseHz(value string) (rf.Hz, error) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, ErrInvalidFile
+	}
+	return rf.Hz(f), nil
+}
+
+// formatHz will format a whole number of Hz.
+func formatHz(freq rf.Hz) string {
+	return strconv.FormatFloat(math.Round(float64(freq)), 'f', 0, 64)
+}
+
+// ReadGQRXBookmarks will read a GQRX bookmarks.csv file. The Color of each
+// bookmark is the color of its first tag.
+func ReadGQRXBookmarks(r io.Reader) (rf.Allocations, error) {
+	colors := map[string]string{}
+	ret := rf.Allocations{}
+	err := gqrxLines(r, ";", func(fields []string) error {
+		switch len(fields) {
+		case 2:
+			colors[fields[0]] = fields[1]
+			return nil
+		case 5:
+		default:
+			return ErrInvalidFile
+		}
+		freq, err := parseHz(fields[0])
+		if err != nil {
+			return err
+		}
+		bandwidth, err := parseHz(fields[3])
+		if err != nil {
+			return err
+		}
+		m := Metadata{
+			Mode:      gqrxModes.mode(fields[2]),
+			Bandwidth: bandwidth,
+			Tags:      []string{},
+		}
+		for _, tag := range strings.Split(fields[4], ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				m.Tags = append(m.Tags, tag)
+			}
+		}
+		ret = append(ret, bookmark(fields[1], freq, m))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range ret {
+		m := a.Metadata.(*Metadata)
+		if color, ok := colors[m.tag("Untagged")]; ok {
+			m.Color = color
+		}
+	}
+	return ret, nil
+}
+
+// WriteGQRXBookmarks will write the Allocations as a GQRX bookmarks.csv
+// file, at the center of each Allocation's Range. Each tag's color is the
+// Color of the first Allocation with that as its first tag.
+func WriteGQRXBookmarks(w io.Writer, allocations rf.Allocations) error {
+	tags := []string{}
+	colors := map[string]string{}
+	for _, a := range allocations {
+		m := metadata(a)
+		for _, tag := range m.Tags {
+			if _, ok := colors[tag]; !ok {
+				tags = append(tags, tag)
+				colors[tag] = ""
+			}
+		}
+		if tag := m.tag(""); tag != "" && colors[tag] == "" {
+			colors[tag] = m.Color
+		}
+	}
+	for tag, color := range colors {
+		if color == "" {
+			colors[tag] = gqrxDefaultColor
+		}
+	}
+
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "# Tag name          ;  color\n")
+	for _, tag := range tags {
+		fmt.Fprintf(b, "%-20s; %s\n", tag, colors[tag])
+	}
+	fmt.Fprintf(b, "\n# Frequency ; Name                     ; Modulation          ;  Bandwidth; Tags\n")
+	for _, a := range allocations {
+		m := metadata(a)
+		fmt.Fprintf(b, "%12s; %-25s; %-20s; %10s; %s\n",
+			formatHz(a.Range.Center()),
+			a.Name,
+			gqrxModes.name(m.Mode),
+			formatHz(m.Bandwidth),
+			strings.Join(m.Tags, ","),
+		)
+	}
+	return b.Flush()
+}
+
+// ReadGQRXBandplan will read a GQRX bandplan.csv file, whose columns are
+// the start and end frequency, mode, step, color and name of each band.
+func ReadGQRXBandplan(r io.Reader) (rf.Allocations, error) {
+	ret := rf.Allocations{}
+	err := gqrxLines(r, ",", func(fields []string) error {
+		if len(fields) != 6 {
+			return ErrInvalidFile
+		}
+		var (
+			numbers [3]rf.Hz
+			err     error
+		)
+		for i, field := range []string{fields[0], fields[1], fields[3]} {
+			if numbers[i], err = parseHz(field); err != nil {
+				return err
+			}
+		}
+		ret = append(ret, rf.Allocation{
+			Name:  fields[5],
+			Range: rf.Range{numbers[0], numbers[1]},
+			Metadata: &Metadata{
+				Mode:  gqrxModes.mode(fields[2]),
+				Step:  numbers[2],
+				Color: fields[4],
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// WriteGQRXBandplan will write the Allocations as a GQRX bandplan.csv file.
+func WriteGQRXBandplan(w io.Writer, allocations rf.Allocations) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "# Start freq, End freq, Mode, Step, Color, Name\n")
+	for _, a := range allocations {
+		m := metadata(a)
+		color := m.Color
+		if color == "" {
+			color = gqrxDefaultColor
+		}
+		fmt.Fprintf(b, "%s, %s, %s, %s, %s, %s\n",
+			formatHz(a.Range[0]),
+			formatHz(a.Range[1]),
+			gqrxModes.name(m.Mode),
+			formatHz(m.Step),
+			color,
+			a.Name,
+		)
+	}
+	return b.Flush()
+}
+
+// vim: foldmethod=marker
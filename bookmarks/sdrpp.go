@@ -0,0 +1,246 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package bookmarks
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"hz.tools/rf"
+)
+
+// sdrppModes are SDR++'s demodulator numbers for each Mode.
+var sdrppModes = []Mode{NFM, WFM, AM, DSB, USB, CW, LSB, Raw}
+
+// sdrppDefaultList is the list SDR++ creates, and the one untagged
+// bookmarks are written to.
+const sdrppDefaultList = "General"
+
+// sdrppBookmark is a bookmark in the SDR++ frequency manager config.
+type sdrppBookmark struct {
+	Frequency float64 `json:"frequency"`
+	Bandwidth float64 `json:"bandwidth"`
+	Mode      int     `json:"mode"`
+}
+
+// sdrppList is a list of bookmarks in the SDR++ frequency manager config.
+type sdrppList struct {
+	ShowOnWaterfall bool                     `json:"showOnWaterfall"`
+	Bookmarks       map[string]sdrppBookmark `json:"bookmarks"`
+}
+
+// sdrppConfig is the SDR++ frequency manager config.
+type sdrppConfig struct {
+	BookmarkDisplayMode int                  `json:"bookmarkDisplayMode"`
+	Lists               map[string]sdrppList `json:"lists"`
+	SelectedList        string               `json:"selectedList"`
+}
+
+// ReadSDRPPBookmarks will read the SDR++ frequency manager config, with
+// each bookmark's list as its only tag. SDR++ doesn't keep the order of
+// bookmarks, so they're returned ordered by list, then frequency.
+func ReadSDRPPBookmarks(r io.Reader) (rf.Allocations, error) {
+	config := sdrppConfig{}
+	if err := json.NewDecoder(r).Decode(&config); err != nil {
+		return nil, ErrInvalidFile
+	}
+	ret := rf.Allocations{}
+	for list, bookmarks := range config.Lists {
+		for name, b := range bookmarks.Bookmarks {
+			if b.Mode < 0 || b.Mode >= len(sdrppModes) {
+				return nil, ErrInvalidFile
+			}
+			ret = append(ret, bookmark(name, rf.Hz(b.Frequency), Metadata{
+				Mode:      sdrppModes[b.Mode],
+				Bandwidth: rf.Hz(b.Bandwidth),
+				Tags:      []string{list},
+			}))
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		li, lj := metadata(ret[i]).Tags[0], metadata(ret[j]).Tags[0]
+		if li != lj {
+			return li < lj
+		}
+		if ret[i].Range[0] != ret[j].Range[0] {
+			return ret[i].Range[0] < ret[j].Range[0]
+		}
+		return ret[i].Name < ret[j].Name
+	})
+	return ret, nil
+}
+
+// WriteSDRPPBookmarks will write the Allocations as an SDR++ frequency
+// manager config, at the center of each Allocation's Range, in the list
+// named by its first tag. SDR++ bookmark names are unique within a list,
+// so later Allocations replace earlier ones of the same name, and Modes
+// SDR++ doesn't support are written as NFM.
+func WriteSDRPPBookmarks(w io.Writer, allocations rf.Allocations) error {
+	config := sdrppConfig{
+		Lists:        map[string]sdrppList{},
+		SelectedList: sdrppDefaultList,
+	}
+	for _, a := range allocations {
+		m := metadata(a)
+		name := m.tag(sdrppDefaultList)
+		list, ok := config.Lists[name]
+		if !ok {
+			list = sdrppList{
+				ShowOnWaterfall: true,
+				Bookmarks:       map[string]sdrppBookmark{},
+			}
+			config.Lists[name] = list
+		}
+		b := sdrppBookmark{
+			Frequency: float64(a.Range.Center()),
+			Bandwidth: float64(m.Bandwidth),
+		}
+		for i, mode := range sdrppModes {
+			if mode == m.Mode {
+				b.Mode = i
+				break
+			}
+		}
+		list.Bookmarks[a.Name] = b
+	}
+	if _, ok := config.Lists[sdrppDefaultList]; !ok {
+		names := []string{}
+		for name := range config.Lists {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if len(names) > 0 {
+			config.SelectedList = names[0]
+		}
+	}
+	return writeSDRPPJSON(w, config)
+}
+
+// SDRPPBandPlan is an SDR++ band plan. The type of each band is its only
+// tag.
+type SDRPPBandPlan struct {
+	Name        string
+	CountryName string
+	CountryCode string
+	AuthorName  string
+	AuthorURL   string
+	Bands       rf.Allocations
+}
+
+// sdrppBand is a band in an SDR++ band plan.
+type sdrppBand struct {
+	Name  string  `json:"name"`
+	Type  string  `json:"type"`
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
+
+// sdrppBandPlan is the SDR++ band plan file.
+type sdrppBandPlan struct {
+	Name        string      `json:"name"`
+	CountryName string      `json:"country_name"`
+	CountryCode string      `json:"country_code"`
+	AuthorName  string      `json:"author_name"`
+	AuthorURL   string      `json:"author_url"`
+	Bands       []sdrppBand `json:"bands"`
+}
+
+// ReadSDRPPBandPlan will read an SDR++ band plan. SDR++ colors bands by
+// type, from its band_colors.json; if colors is not nil, it will be read
+// to set the Color of each band.
+func ReadSDRPPBandPlan(plan io.Reader, colors io.Reader) (SDRPPBandPlan, error) {
+	file := sdrppBandPlan{}
+	if err := json.NewDecoder(plan).Decode(&file); err != nil {
+		return SDRPPBandPlan{}, ErrInvalidFile
+	}
+	typeColors := map[string]string{}
+	if colors != nil {
+		if err := json.NewDecoder(colors).Decode(&typeColors); err != nil {
+			return SDRPPBandPlan{}, ErrInvalidFile
+		}
+	}
+	ret := SDRPPBandPlan{
+		Name:        file.Name,
+		CountryName: file.CountryName,
+		CountryCode: file.CountryCode,
+		AuthorName:  file.AuthorName,
+		AuthorURL:   file.AuthorURL,
+		Bands:       make(rf.Allocations, len(file.Bands)),
+	}
+	for i, band := range file.Bands {
+		ret.Bands[i] = rf.Allocation{
+			Name:  band.Name,
+			Range: rf.Range{rf.Hz(band.Start), rf.Hz(band.End)},
+			Metadata: &Metadata{
+				Color: typeColors[band.Type],
+				Tags:  []string{band.Type},
+			},
+		}
+	}
+	return ret, nil
+}
+
+// Write will write the SDRPPBandPlan as an SDR++ band plan, using the
+// first tag of each band as its type.
+func (p SDRPPBandPlan) Write(w io.Writer) error {
+	file := sdrppBandPlan{
+		Name:        p.Name,
+		CountryName: p.CountryName,
+		CountryCode: p.CountryCode,
+		AuthorName:  p.AuthorName,
+		AuthorURL:   p.AuthorURL,
+		Bands:       make([]sdrppBand, len(p.Bands)),
+	}
+	for i, a := range p.Bands {
+		file.Bands[i] = sdrppBand{
+			Name:  a.Name,
+			Type:  metadata(a).tag(""),
+			Start: float64(a.Range[0]),
+			End:   float64(a.Range[1]),
+		}
+	}
+	return writeSDRPPJSON(w, file)
+}
+
+// WriteColors will write the colors of each band type as an SDR++
+// band_colors.json, using the Color of the first band of each type.
+func (p SDRPPBandPlan) WriteColors(w io.Writer) error {
+	colors := map[string]string{}
+	for _, a := range p.Bands {
+		m := metadata(a)
+		if _, ok := colors[m.tag("")]; ok || m.Color == "" {
+			continue
+		}
+		colors[m.tag("")] = m.Color
+	}
+	return writeSDRPPJSON(w, colors)
+}
+
+// writeSDRPPJSON will write the value as indented JSON, the way SDR++
+// writes its config.
+func writeSDRPPJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	return enc.Encode(v)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,118 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package bookmarks
+
+import (
+	"encoding/xml"
+	"io"
+	"math"
+
+	"hz.tools/rf"
+)
+
+// sdrSharpModes are SDR#'s names for each Mode.
+var sdrSharpModes = modeTable{
+	{NFM, "NFM"},
+	{WFM, "WFM"},
+	{AM, "AM"},
+	{DSB, "DSB"},
+	{USB, "USB"},
+	{LSB, "LSB"},
+	{CW, "CW"},
+	{Raw, "RAW"},
+}
+
+// sdrSharpEntry is a MemoryEntry in an SDR# frequencies.xml file.
+type sdrSharpEntry struct {
+	IsFavourite     bool
+	Name            string
+	GroupName       string
+	Frequency       int64
+	DetectorType    string
+	Shift           int64
+	FilterBandwidth int64
+}
+
+// sdrSharpFile is the root element of an SDR# frequencies.xml file.
+type sdrSharpFile struct {
+	XMLName xml.Name        `xml:"ArrayOfMemoryEntry"`
+	XSI     string          `xml:"xmlns:xsi,attr,omitempty"`
+	XSD     string          `xml:"xmlns:xsd,attr,omitempty"`
+	Entries []sdrSharpEntry `xml:"MemoryEntry"`
+}
+
+// ReadSDRSharp will read an SDR# frequencies.xml file. The GroupName of
+// each entry is its only tag. The frequency shift is not supported, and
+// is ignored.
+func ReadSDRSharp(r io.Reader) (rf.Allocations, error) {
+	file := sdrSharpFile{}
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, ErrInvalidFile
+	}
+	ret := make(rf.Allocations, len(file.Entries))
+	for i, entry := range file.Entries {
+		m := Metadata{
+			Mode:      sdrSharpModes.mode(entry.DetectorType),
+			Bandwidth: rf.Hz(entry.FilterBandwidth),
+			Tags:      []string{},
+			Favourite: entry.IsFavourite,
+		}
+		if entry.GroupName != "" {
+			m.Tags = append(m.Tags, entry.GroupName)
+		}
+		ret[i] = bookmark(entry.Name, rf.Hz(entry.Frequency), m)
+	}
+	return ret, nil
+}
+
+// WriteSDRSharp will write the Allocations as an SDR# frequencies.xml
+// file, at the center of each Allocation's Range, using the first tag as
+// the GroupName.
+func WriteSDRSharp(w io.Writer, allocations rf.Allocations) error {
+	file := sdrSharpFile{
+		XSI:     "http://www.w3.org/2001/XMLSchema-instance",
+		XSD:     "http://www.w3.org/2001/XMLSchema",
+		Entries: make([]sdrSharpEntry, len(allocations)),
+	}
+	for i, a := range allocations {
+		m := metadata(a)
+		file.Entries[i] = sdrSharpEntry{
+			IsFavourite:     m.Favourite,
+			Name:            a.Name,
+			GroupName:       m.tag(""),
+			Frequency:       int64(math.Round(float64(a.Range.Center()))),
+			DetectorType:    sdrSharpModes.name(m.Mode),
+			FilterBandwidth: int64(math.Round(float64(m.Bandwidth))),
+		}
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(file); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// vim: foldmethod=marker
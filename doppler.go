@@ -0,0 +1,66 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package rf
+
+import (
+	"math"
+)
+
+// Doppler will return the frequency observed when the distance between the
+// transmitter and receiver is changing at relativeVelocity meters per second.
+// A positive relativeVelocity means the two ends are moving apart, which
+// will lower the observed frequency.
+//
+// This is the classical approximation, which is accurate when the velocity
+// is much lower than the speed of light. See RelativisticDoppler for space
+// links.
+func (h Hz) Doppler(relativeVelocity float64) Hz {
+	return h * Hz(1-relativeVelocity/SpeedOfLight)
+}
+
+// RelativisticDoppler will return the frequency observed when the distance
+// between the transmitter and receiver is changing at relativeVelocity meters
+// per second, using the relativistic longitudinal Doppler formula. A positive
+// relativeVelocity means the two ends are moving apart.
+func (h Hz) RelativisticDoppler(relativeVelocity float64) Hz {
+	beta := relativeVelocity / SpeedOfLight
+	return h * Hz(math.Sqrt((1-beta)/(1+beta)))
+}
+
+// Doppler will return the Range as observed when the distance between the
+// transmitter and receiver is changing at relativeVelocity meters per
+// second. Both edges of the Range are shifted, which may move the Range out
+// of a receiver's passband.
+func (r Range) Doppler(relativeVelocity float64) Range {
+	return Range{r[0].Doppler(relativeVelocity), r[1].Doppler(relativeVelocity)}
+}
+
+// RelativisticDoppler will return the Range as observed when the distance
+// between the transmitter and receiver is changing at relativeVelocity
+// meters per second, using Hz.RelativisticDoppler.
+func (r Range) RelativisticDoppler(relativeVelocity float64) Range {
+	return Range{
+		r[0].RelativisticDoppler(relativeVelocity),
+		r[1].RelativisticDoppler(relativeVelocity),
+	}
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,126 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package doppler
+
+import (
+	"math"
+
+	"hz.tools/rf"
+)
+
+// Vector is a position, velocity or acceleration in three dimensions, in
+// meters (or meters per second, or meters per second squared), in any
+// inertial reference frame -- such as Earth-Centered Inertial.
+type Vector [3]float64
+
+func (v Vector) sub(o Vector) Vector {
+	return Vector{v[0] - o[0], v[1] - o[1], v[2] - o[2]}
+}
+
+func (v Vector) add(o Vector) Vector {
+	return Vector{v[0] + o[0], v[1] + o[1], v[2] + o[2]}
+}
+
+func (v Vector) scale(s float64) Vector {
+	return Vector{v[0] * s, v[1] * s, v[2] * s}
+}
+
+func (v Vector) dot(o Vector) float64 {
+	return v[0]*o[0] + v[1]*o[1] + v[2]*o[2]
+}
+
+func (v Vector) norm() float64 {
+	return math.Sqrt(v.dot(v))
+}
+
+// State is the position, velocity and acceleration of one end of a radio
+// link. An unknown Acceleration may be left as zero, in which case the
+// velocity is assumed to be constant.
+type State struct {
+	Position     Vector
+	Velocity     Vector
+	Acceleration Vector
+}
+
+// at will return the State advanced by t seconds, assuming constant
+// acceleration.
+func (s State) at(t float64) State {
+	return State{
+		Position: s.Position.
+			add(s.Velocity.scale(t)).
+			add(s.Acceleration.scale(t * t / 2)),
+		Velocity:     s.Velocity.add(s.Acceleration.scale(t)),
+		Acceleration: s.Acceleration,
+	}
+}
+
+// Between will return the frequency observed by the receiver rx of a signal
+// sent at freq by the transmitter tx, as well as the rate of
+// change of the observed frequency in Hz per second.
+//
+// This uses the classical approximation, and ignores light travel time.
+func Between(freq rf.Hz, tx, rx State) (rf.Hz, rf.Hz) {
+	p := rx.Position.sub(tx.Position)
+	v := rx.Velocity.sub(tx.Velocity)
+	a := rx.Acceleration.sub(tx.Acceleration)
+
+	r := p.norm()
+	if r == 0 {
+		return freq, 0
+	}
+	rangeRate := p.dot(v) / r
+	rangeAccel := (v.dot(v) + p.dot(a) - rangeRate*rangeRate) / r
+	return freq.Doppler(rangeRate), -freq * rf.Hz(rangeAccel/rf.SpeedOfLight)
+}
+
+// relativisticRatio will return the ratio of the received to transmitted
+// frequency in the reference frame the States are expressed in, including
+// the transverse (time dilation) term.
+func relativisticRatio(tx, rx State) float64 {
+	p := rx.Position.sub(tx.Position)
+	r := p.norm()
+	if r == 0 {
+		return 1
+	}
+	n := p.scale(1 / r)
+	gamma := func(v Vector) float64 {
+		beta := v.norm() / rf.SpeedOfLight
+		return 1 / math.Sqrt(1-beta*beta)
+	}
+	return gamma(rx.Velocity) * (1 - n.dot(rx.Velocity)/rf.SpeedOfLight) /
+		(gamma(tx.Velocity) * (1 - n.dot(tx.Velocity)/rf.SpeedOfLight))
+}
+
+// RelativisticBetween will return the frequency observed by the receiver rx
+// of a signal sent at freq by the transmitter tx, as
+// well as the rate of change of the observed frequency in Hz per second.
+//
+// Unlike Between, this includes the relativistic transverse Doppler
+// effect, which is significant for links to spacecraft. Light travel time is
+// still ignored.
+func RelativisticBetween(freq rf.Hz, tx, rx State) (rf.Hz, rf.Hz) {
+	const dt = 1e-3
+	before := relativisticRatio(tx.at(-dt), rx.at(-dt))
+	after := relativisticRatio(tx.at(dt), rx.at(dt))
+	return freq * rf.Hz(relativisticRatio(tx, rx)), freq * rf.Hz((after-before)/(2*dt))
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,79 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package doppler_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/doppler"
+)
+
+func TestBetween(t *testing.T) {
+	rx := doppler.State{}
+
+	// Flying directly at the receiver.
+	tx := doppler.State{
+		Position: doppler.Vector{10000, 0, 0},
+		Velocity: doppler.Vector{-300, 0, 0},
+	}
+	freq, rate := doppler.Between(rf.GHz, tx, rx)
+	assert.InDelta(t, 1e9+1000.69, float64(freq), 0.01)
+	assert.InDelta(t, 0, float64(rate), 1e-9)
+
+	// Passing overhead, at the point of closest approach.
+	tx = doppler.State{
+		Position: doppler.Vector{0, 0, 1000},
+		Velocity: doppler.Vector{300, 0, 0},
+	}
+	freq, rate = doppler.Between(rf.GHz, tx, rx)
+	assert.Equal(t, rf.GHz, freq)
+	assert.InDelta(t, -300.21, float64(rate), 0.01)
+
+	rfreq, rrate := doppler.RelativisticBetween(rf.GHz, tx, rx)
+	assert.InDelta(t, float64(freq), float64(rfreq), 0.001)
+	assert.InDelta(t, float64(rate), float64(rrate), 0.01)
+}
+
+func TestRelativisticBetweenTransverse(t *testing.T) {
+	const v = 7500.0
+	gamma := 1 / math.Sqrt(1-(v*v)/(rf.SpeedOfLight*rf.SpeedOfLight))
+
+	// A moving transmitter's clock runs slow, so a receiver at rest sees
+	// a redshift of 1/γ when the motion is entirely sideways.
+	tx := doppler.State{
+		Position: doppler.Vector{0, 0, 1000000},
+		Velocity: doppler.Vector{v, 0, 0},
+	}
+	freq, _ := doppler.RelativisticBetween(rf.GHz, tx, doppler.State{})
+	assert.InDelta(t, 1/gamma, float64(freq/rf.GHz), 1e-12)
+	assert.True(t, freq < rf.GHz)
+
+	// A moving receiver's clock runs slow too, so it sees a blueshift of γ.
+	freq, _ = doppler.RelativisticBetween(rf.GHz, doppler.State{}, tx)
+	assert.InDelta(t, gamma, float64(freq/rf.GHz), 1e-12)
+	assert.True(t, freq > rf.GHz)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,42 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package rf_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+)
+
+func TestDoppler(t *testing.T) {
+	f := rf.KHz * 437800
+	assert.InDelta(t, 437800000+10222.4, float64(f.Doppler(-7000)), 1)
+	assert.InDelta(t, 437800000-10222.4, float64(f.Doppler(7000)), 1)
+	assert.InDelta(t, float64(f.Doppler(7000)), float64(f.RelativisticDoppler(7000)), 1)
+
+	r := rf.Range{rf.MHz * 100, rf.MHz * 101}.Doppler(-rf.SpeedOfLight / 1000)
+	assert.InDelta(t, 100.1e6, float64(r[0]), 1e-3)
+	assert.InDelta(t, 101.101e6, float64(r[1]), 1e-3)
+}
+
+// vim: foldmethod=marker
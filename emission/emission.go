@@ -0,0 +1,369 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package emission
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+// Modulation is the first symbol of the emission class, which
+// describes the type of modulation of the main carrier.
+type Modulation byte
+
+// Modulation types, as defined by ITU Radio Regulations Appendix 1.
+const (
+	ModulationNone              Modulation = 'N'
+	ModulationAM                Modulation = 'A'
+	ModulationSSBFullCarrier    Modulation = 'H'
+	ModulationSSBReducedCarrier Modulation = 'R'
+	ModulationSSB               Modulation = 'J'
+	ModulationISB               Modulation = 'B'
+	ModulationVSB               Modulation = 'C'
+	ModulationFM                Modulation = 'F'
+	ModulationPM                Modulation = 'G'
+	ModulationAMAngle           Modulation = 'D'
+	ModulationPulse             Modulation = 'P'
+	ModulationPulseAmplitude    Modulation = 'K'
+	ModulationPulseWidth        Modulation = 'L'
+	ModulationPulsePosition     Modulation = 'M'
+	ModulationPulseAngle        Modulation = 'Q'
+	ModulationPulseCombination  Modulation = 'V'
+	ModulationCombination       Modulation = 'W'
+	ModulationOther             Modulation = 'X'
+)
+
+var modulationNames = map[Modulation]string{
+	ModulationNone:              "unmodulated carrier",
+	ModulationAM:                "double-sideband amplitude modulation",
+	ModulationSSBFullCarrier:    "single-sideband, full carrier",
+	ModulationSSBReducedCarrier: "single-sideband, reduced carrier",
+	ModulationSSB:               "single-sideband, suppressed carrier",
+	ModulationISB:               "independent sidebands",
+	ModulationVSB:               "vestigial sideband",
+	ModulationFM:                "frequency modulation",
+	ModulationPM:                "phase modulation",
+	ModulationAMAngle:           "amplitude and angle modulation",
+	ModulationPulse:             "unmodulated pulses",
+	ModulationPulseAmplitude:    "pulses, amplitude modulated",
+	ModulationPulseWidth:        "pulses, width modulated",
+	ModulationPulsePosition:     "pulses, position modulated",
+	ModulationPulseAngle:        "pulses, angle modulated carrier",
+	ModulationPulseCombination:  "pulses, combination",
+	ModulationCombination:       "combination of modulation types",
+	ModulationOther:             "other",
+}
+
+// Valid will return true if the symbol is a known modulation type.
+func (m Modulation) Valid() bool {
+	_, ok := modulationNames[m]
+	return ok
+}
+
+// String will return a human readable description of the modulation type.
+func (m Modulation) String() string {
+	return modulationNames[m]
+}
+
+// Signal is the second symbol of the emission class, which describes
+// the nature of the signal(s) modulating the main carrier.
+type Signal byte
+
+// Signal types, as defined by ITU Radio Regulations Appendix 1.
+const (
+	SignalNone              Signal = '0'
+	SignalDigital           Signal = '1'
+	SignalDigitalSubcarrier Signal = '2'
+	SignalAnalog            Signal = '3'
+	SignalMultiDigital      Signal = '7'
+	SignalMultiAnalog       Signal = '8'
+	SignalComposite         Signal = '9'
+	SignalOther             Signal = 'X'
+)
+
+var signalNames = map[Signal]string{
+	SignalNone:              "no modulating signal",
+	SignalDigital:           "single channel digital, no subcarrier",
+	SignalDigitalSubcarrier: "single channel digital, with subcarrier",
+	SignalAnalog:            "single channel analog",
+	SignalMultiDigital:      "two or more channels, digital",
+	SignalMultiAnalog:       "two or more channels, analog",
+	SignalComposite:         "composite of digital and analog channels",
+	SignalOther:             "other",
+}
+
+// Valid will return true if the symbol is a known signal type.
+func (s Signal) Valid() bool {
+	_, ok := signalNames[s]
+	return ok
+}
+
+// String will return a human readable description of the signal type.
+func (s Signal) String() string {
+	return signalNames[s]
+}
+
+// Information is the third symbol of the emission class, which
+// describes the type of information being transmitted.
+type Information byte
+
+// Information types, as defined by ITU Radio Regulations Appendix 1.
+const (
+	InformationNone                Information = 'N'
+	InformationAuralTelegraphy     Information = 'A'
+	InformationAutomaticTelegraphy Information = 'B'
+	InformationFacsimile           Information = 'C'
+	InformationData                Information = 'D'
+	InformationTelephony           Information = 'E'
+	InformationTelevision          Information = 'F'
+	InformationCombination         Information = 'W'
+	InformationOther               Information = 'X'
+)
+
+var informationNames = map[Information]string{
+	InformationNone:                "no information transmitted",
+	InformationAuralTelegraphy:     "telegraphy, for aural reception",
+	InformationAutomaticTelegraphy: "telegraphy, for automatic reception",
+	InformationFacsimile:           "facsimile",
+	InformationData:                "data transmission, telemetry, telecommand",
+	InformationTelephony:           "telephony",
+	InformationTelevision:          "television",
+	InformationCombination:         "combination of the above",
+	InformationOther:               "other",
+}
+
+// Valid will return true if the symbol is a known information type.
+func (i Information) Valid() bool {
+	_, ok := informationNames[i]
+	return ok
+}
+
+// String will return a human readable description of the information type.
+func (i Information) String() string {
+	return informationNames[i]
+}
+
+// Designator is an ITU emission designator, such as "16K0F3E" (analog FM
+// voice) or "2K80J3E" (SSB voice), made up of the necessary bandwidth and
+// the three symbol emission class. The optional fourth and fifth symbols
+// (details of the signal and nature of multiplexing) are kept as-is in
+// Supplementary.
+type Designator struct {
+	// Bandwidth is the necessary bandwidth of the emission.
+	Bandwidth rf.Hz
+
+	// Modulation is the type of modulation of the main carrier.
+	Modulation Modulation
+
+	// Signal is the nature of the signal(s) modulating the main carrier.
+	Signal Signal
+
+	// Information is the type of information being transmitted.
+	Information Information
+
+	// Supplementary are the optional fourth and fifth symbols, if any.
+	Supplementary string
+}
+
+// units are the letters used in place of the decimal point in the
+// necessary bandwidth, in ascending order.
+var units = []struct {
+	letter byte
+	scale  rf.Hz
+}{
+	{'H', 1},
+	{'K', rf.KHz},
+	{'M', rf.MHz},
+	{'G', rf.GHz},
+}
+
+// formatBandwidth will format the bandwidth as the four character
+// necessary bandwidth prefix, such as "16K0" or "2K80".
+func formatBandwidth(bw rf.Hz) (string, error) {
+	if bw < rf.Hz(0.001) {
+		return "", fmt.Errorf("emission: bandwidth out of range: %s", bw)
+	}
+
+	// Round to three significant figures before picking the unit, since
+	// 999.96Hz should be written as "1K00", not "1000H".
+	scale := math.Pow(10, math.Floor(math.Log10(float64(bw)))-2)
+	rounded := math.Round(float64(bw)/scale) * scale
+
+	for _, unit := range units {
+		value := rounded / float64(unit.scale)
+		letter := string(unit.letter)
+		switch {
+		case value >= 1000:
+			continue
+		case value < 1:
+			return letter + strconv.FormatFloat(value, 'f', 3, 64)[2:], nil
+		}
+		decimals := 3 - len(strconv.Itoa(int(value)))
+		s := strconv.FormatFloat(value, 'f', decimals, 64)
+		if !strings.Contains(s, ".") {
+			return s + letter, nil
+		}
+		return strings.Replace(s, ".", letter, 1), nil
+	}
+	return "", fmt.Errorf("emission: bandwidth out of range: %s", bw)
+}
+
+// parseBandwidth will parse the four character necessary bandwidth
+// prefix, such as "16K0" or "2K80".
+func parseBandwidth(bw string) (rf.Hz, error) {
+	if len(bw) != 4 {
+		return 0, fmt.Errorf("emission: invalid bandwidth: %s", bw)
+	}
+	for _, unit := range units {
+		i := strings.IndexByte(bw, unit.letter)
+		if i < 0 {
+			continue
+		}
+		digits := bw[:i] + "." + bw[i+1:]
+		if strings.ContainsAny(digits, "HKMG+-") {
+			break
+		}
+		value, err := strconv.ParseFloat(digits, 64)
+		if err != nil || value == 0 {
+			break
+		}
+		return rf.Hz(math.Round(value*float64(unit.scale)*1000) / 1000), nil
+	}
+	return 0, fmt.Errorf("emission: invalid bandwidth: %s", bw)
+}
+
+// Parse will parse an ITU emission designator, such as "16K0F3E",
+// "2K80J3E" or "6K00A3E".
+func Parse(designator string) (Designator, error) {
+	designator = strings.ToUpper(strings.TrimSpace(designator))
+	if len(designator) != 7 && len(designator) != 9 {
+		return Designator{}, fmt.Errorf("emission: invalid designator: %s", designator)
+	}
+
+	bw, err := parseBandwidth(designator[:4])
+	if err != nil {
+		return Designator{}, err
+	}
+
+	e := Designator{
+		Bandwidth:     bw,
+		Modulation:    Modulation(designator[4]),
+		Signal:        Signal(designator[5]),
+		Information:   Information(designator[6]),
+		Supplementary: designator[7:],
+	}
+
+	if !e.Modulation.Valid() || !e.Signal.Valid() || !e.Information.Valid() {
+		return Designator{}, fmt.Errorf("emission: invalid class: %s", designator[4:7])
+	}
+	return e, nil
+}
+
+// MustParse will run the designator through Parse, and on
+// error, panic.
+func MustParse(designator string) Designator {
+	e, err := Parse(designator)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// String will return the emission designator, such as "16K0F3E".
+func (e Designator) String() string {
+	bw, err := formatBandwidth(e.Bandwidth)
+	if err != nil {
+		bw = "????"
+	}
+	return fmt.Sprintf(
+		"%s%c%c%c%s",
+		bw, e.Modulation, e.Signal, e.Information, e.Supplementary,
+	)
+}
+
+// Range will return the rf.Range occupied by the emission's necessary
+// bandwidth, centered on the provided carrier frequency.
+//
+// For single sideband emissions the carrier is at the edge of the signal, so
+// the center of the occupied spectrum should be passed, not the carrier.
+func (e Designator) Range(center rf.Hz) rf.Range {
+	half := e.Bandwidth / 2
+	return rf.Range{-half, half}.Add(center)
+}
+
+// Allocation will return an rf.Allocation covering the emission centered on
+// the provided frequency, with the Designator set as the Metadata.
+func (e Designator) Allocation(name string, center rf.Hz) rf.Allocation {
+	return rf.Allocation{
+		Name:     name,
+		Range:    e.Range(center),
+		Metadata: e,
+	}
+}
+
+// DesignatorOf will return the Designator of an rf.Allocation returned by Designator.Allocation, and false if the
+// rf.Allocation doesn't have one.
+func DesignatorOf(a rf.Allocation) (Designator, bool) {
+	e, ok := a.Metadata.(Designator)
+	return e, ok
+}
+
+// UnmarshalJSON will parse a string as an emission designator.
+func (e *Designator) UnmarshalJSON(data []byte) error {
+	var el string
+	var err error
+
+	if err := json.Unmarshal(data, &el); err != nil {
+		return err
+	}
+	*e, err = Parse(el)
+	return err
+}
+
+// MarshalJSON will convert the Designator to a designator string.
+func (e Designator) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// MarshalYAML will convert the Designator to a designator string.
+func (e Designator) MarshalYAML() (interface{}, error) {
+	return e.String(), nil
+}
+
+// UnmarshalYAML will parse a string as an emission designator.
+func (e *Designator) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var (
+		err        error
+		designator string
+	)
+	if err := unmarshal(&designator); err != nil {
+		return err
+	}
+	*e, err = Parse(designator)
+	return err
+}
+
+// vim: foldmethod=marker
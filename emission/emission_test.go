@@ -0,0 +1,112 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package emission_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/emission"
+)
+
+func TestParse(t *testing.T) {
+	e, err := emission.Parse("16K0F3E")
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*16, e.Bandwidth)
+	assert.Equal(t, emission.ModulationFM, e.Modulation)
+	assert.Equal(t, emission.SignalAnalog, e.Signal)
+	assert.Equal(t, emission.InformationTelephony, e.Information)
+
+	e, err = emission.Parse("2K80J3E")
+	assert.NoError(t, err)
+	assert.Equal(t, rf.Hz(2800), e.Bandwidth)
+	assert.Equal(t, emission.ModulationSSB, e.Modulation)
+
+	e, err = emission.Parse("6k00a3e")
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*6, e.Bandwidth)
+	assert.Equal(t, "6K00A3E", e.String())
+
+	e, err = emission.Parse("H500A1A")
+	assert.NoError(t, err)
+	assert.Equal(t, rf.Hz(0.5), e.Bandwidth)
+
+	e, err = emission.Parse("11K2F3EJN")
+	assert.NoError(t, err)
+	assert.Equal(t, "JN", e.Supplementary)
+	assert.Equal(t, "11K2F3EJN", e.String())
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, designator := range []string{
+		"", "16K0F3", "16KKF3E", "16K0Z3E", "16K0F5E", "16K0F3Z", "0000F3E", "-6K0F3E",
+	} {
+		_, err := emission.Parse(designator)
+		assert.Error(t, err, designator)
+	}
+}
+
+func TestString(t *testing.T) {
+	for bw, expected := range map[rf.Hz]string{
+		rf.Hz(400):       "400H",
+		rf.Hz(999.96):    "1K00",
+		rf.KHz * 12.5:    "12K5",
+		rf.KHz * 180:     "180K",
+		rf.MHz * 1.25:    "1M25",
+		rf.MHz * 20:      "20M0",
+		rf.GHz * 1.5:     "1G50",
+		rf.Hz(0.1):       "H100",
+		rf.KHz * 2.80001: "2K80",
+	} {
+		e := emission.Designator{
+			Bandwidth:   bw,
+			Modulation:  emission.ModulationFM,
+			Signal:      emission.SignalAnalog,
+			Information: emission.InformationTelephony,
+		}
+		assert.Equal(t, expected+"F3E", e.String())
+	}
+}
+
+func TestRange(t *testing.T) {
+	e := emission.MustParse("16K0F3E")
+	assert.Equal(t, rf.Range{rf.KHz * 146512, rf.KHz * 146528}, e.Range(rf.KHz*146520))
+
+	allocation := e.Allocation("FM Simplex", rf.KHz*146520)
+	designator, ok := emission.DesignatorOf(allocation)
+	assert.True(t, ok)
+	assert.Equal(t, e, designator)
+}
+
+func TestJSON(t *testing.T) {
+	var e emission.Designator
+	assert.NoError(t, json.Unmarshal([]byte(`"2K80J3E"`), &e))
+	assert.Equal(t, emission.ModulationSSB, e.Modulation)
+
+	data, err := json.Marshal(e)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2K80J3E"`, string(data))
+}
+
+// vim: foldmethod=marker
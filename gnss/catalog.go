@@ -0,0 +1,178 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package gnss
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+var (
+	// fundamental is the GNSS fundamental frequency, 10.23MHz, which most
+	// carriers and chip rates are a multiple of.
+	fundamental = rf.KHz * 10230
+
+	// L1 is the 1575.42MHz carrier shared by GPS L1, Galileo E1, BeiDou
+	// B1C and QZSS L1.
+	L1 = fundamental * 154
+
+	// L2 is the 1227.6MHz GPS L2 carrier.
+	L2 = fundamental * 120
+
+	// L5 is the 1176.45MHz carrier shared by GPS L5, Galileo E5a, BeiDou
+	// B2a, QZSS L5 and NavIC L5.
+	L5 = fundamental * 115
+
+	// E5b is the 1207.14MHz Galileo E5b carrier.
+	E5b = fundamental * 118
+
+	// E6 is the 1278.75MHz carrier shared by Galileo E6 and QZSS L6.
+	E6 = fundamental * 125
+)
+
+var (
+	// ErrInvalidFrequencyChannel will be returned when a GLONASS FDMA
+	// frequency channel number is out of range.
+	ErrInvalidFrequencyChannel = fmt.Errorf("gnss: invalid GLONASS frequency channel")
+
+	// GLONASSL1Base is the GLONASS L1 FDMA carrier for frequency channel 0.
+	GLONASSL1Base = rf.MHz * 1602
+
+	// GLONASSL1Step is the GLONASS L1 FDMA frequency channel spacing.
+	GLONASSL1Step = rf.KHz * 562.5
+
+	// GLONASSL2Base is the GLONASS L2 FDMA carrier for frequency channel 0.
+	GLONASSL2Base = rf.MHz * 1246
+
+	// GLONASSL2Step is the GLONASS L2 FDMA frequency channel spacing.
+	GLONASSL2Step = rf.KHz * 437.5
+)
+
+const (
+	// GLONASSMinChannel is the lowest GLONASS FDMA frequency channel number.
+	GLONASSMinChannel = -7
+
+	// GLONASSMaxChannel is the highest GLONASS FDMA frequency channel number.
+	GLONASSMaxChannel = 6
+)
+
+// glonassFDMA will compute the FDMA signal for frequency channel k.
+func glonassFDMA(name string, base, step rf.Hz, k int) (Signal, error) {
+	if k < GLONASSMinChannel || k > GLONASSMaxChannel {
+		return Signal{}, ErrInvalidFrequencyChannel
+	}
+	return Signal{
+		System:     GLONASS,
+		Name:       fmt.Sprintf("%s k=%d", name, k),
+		Carrier:    base + step*rf.Hz(k),
+		Bandwidth:  rf.KHz * 1022,
+		ChipRate:   rf.KHz * 511,
+		Modulation: "BPSK(0.5)",
+	}, nil
+}
+
+// GLONASSL1 will return the GLONASS L1OF signal for FDMA frequency channel k,
+// from -7 to +6, whose carrier is 1602MHz + k*562.5kHz.
+func GLONASSL1(k int) (Signal, error) {
+	return glonassFDMA("L1OF", GLONASSL1Base, GLONASSL1Step, k)
+}
+
+// GLONASSL2 will return the GLONASS L2OF signal for FDMA frequency channel k,
+// from -7 to +6, whose carrier is 1246MHz + k*437.5kHz.
+func GLONASSL2(k int) (Signal, error) {
+	return glonassFDMA("L2OF", GLONASSL2Base, GLONASSL2Step, k)
+}
+
+// bpsk will create a BPSK(n) signal, whose chip rate is n*1.023MHz.
+func bpsk(system System, name string, carrier rf.Hz, n float64) Signal {
+	chipRate := fundamental / 10 * rf.Hz(n)
+	return Signal{
+		System:     system,
+		Name:       name,
+		Carrier:    carrier,
+		Bandwidth:  chipRate * 2,
+		ChipRate:   chipRate,
+		Modulation: fmt.Sprintf("BPSK(%g)", n),
+	}
+}
+
+// qpsk will create a QPSK(n) signal, whose chip rate is n*1.023MHz.
+func qpsk(system System, name string, carrier rf.Hz, n float64) Signal {
+	s := bpsk(system, name, carrier, n)
+	s.Modulation = fmt.Sprintf("QPSK(%g)", n)
+	return s
+}
+
+// boc will create a BOC-family signal with a 1.023MHz chip rate, whose main
+// lobes are those of BOC(1,1).
+func boc(system System, name string, carrier rf.Hz, modulation string) Signal {
+	chipRate := fundamental / 10
+	return Signal{
+		System:     system,
+		Name:       name,
+		Carrier:    carrier,
+		Bandwidth:  chipRate * 4,
+		ChipRate:   chipRate,
+		Modulation: modulation,
+	}
+}
+
+// Catalog are the civil signals of every System in this package. GLONASS
+// FDMA signals are listed once per frequency channel.
+var Catalog = func() Signals {
+	ret := Signals{
+		bpsk(GPS, "L1 C/A", L1, 1),
+		boc(GPS, "L1C", L1, "TMBOC(6,1,4/33)"),
+		bpsk(GPS, "L2C", L2, 1),
+		qpsk(GPS, "L5", L5, 10),
+
+		boc(Galileo, "E1", L1, "CBOC(6,1,1/11)"),
+		qpsk(Galileo, "E5a", L5, 10),
+		qpsk(Galileo, "E5b", E5b, 10),
+		bpsk(Galileo, "E6", E6, 5),
+
+		bpsk(BeiDou, "B1I", rf.KHz*1561098, 2),
+		boc(BeiDou, "B1C", L1, "QMBOC(6,1,4/33)"),
+		qpsk(BeiDou, "B2a", L5, 10),
+		bpsk(BeiDou, "B3I", rf.KHz*1268520, 10),
+
+		bpsk(QZSS, "L1 C/A", L1, 1),
+		boc(QZSS, "L1C", L1, "TMBOC(6,1,4/33)"),
+		bpsk(QZSS, "L2C", L2, 1),
+		qpsk(QZSS, "L5", L5, 10),
+		bpsk(QZSS, "L6", E6, 5),
+
+		bpsk(NavIC, "L5 SPS", L5, 1),
+		bpsk(NavIC, "S SPS", rf.KHz*2492028, 1),
+
+		qpsk(GLONASS, "L3OC", fundamental*117.5, 10),
+	}
+
+	for k := GLONASSMinChannel; k <= GLONASSMaxChannel; k++ {
+		l1, _ := GLONASSL1(k)
+		l2, _ := GLONASSL2(k)
+		ret = append(ret, l1, l2)
+	}
+	return ret
+}()
+
+// vim: foldmethod=marker
@@ -0,0 +1,137 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package gnss
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+// System is a Global Navigation Satellite System, such as GPS or Galileo.
+type System string
+
+const (
+	// GPS is the United States' Global Positioning System.
+	GPS System = "GPS"
+
+	// Galileo is the European Union's GNSS.
+	Galileo System = "Galileo"
+
+	// BeiDou is China's BeiDou Navigation Satellite System.
+	BeiDou System = "BeiDou"
+
+	// GLONASS is Russia's GLObal NAvigation Satellite System.
+	GLONASS System = "GLONASS"
+
+	// QZSS is Japan's Quasi-Zenith Satellite System.
+	QZSS System = "QZSS"
+
+	// NavIC is India's Navigation with Indian Constellation.
+	NavIC System = "NavIC"
+)
+
+// Signal is a single GNSS signal broadcast by a System.
+type Signal struct {
+	// System this signal is broadcast by.
+	System System
+
+	// Name of the signal, such as "L1 C/A".
+	Name string
+
+	// Carrier is the carrier frequency of the signal.
+	Carrier rf.Hz
+
+	// Bandwidth is the null-to-null bandwidth of the main lobe(s) of the
+	// signal, which is the minimum bandwidth a receiver must pass.
+	Bandwidth rf.Hz
+
+	// ChipRate is the spreading code chip rate, in chips per second.
+	ChipRate rf.Hz
+
+	// Modulation is the modulation of the signal, in the usual notation,
+	// such as "BPSK(1)" or "CBOC(6,1,1/11)".
+	Modulation string
+}
+
+// String will return the System and signal name, such as "GPS L1 C/A".
+func (s Signal) String() string {
+	return fmt.Sprintf("%s %s", s.System, s.Name)
+}
+
+// Range will return the range of frequencies occupied by the signal.
+func (s Signal) Range() rf.Range {
+	half := s.Bandwidth / 2
+	return rf.Range{-half, half}.Add(s.Carrier)
+}
+
+// Allocation will return the signal as an rf.Allocation, with the Signal
+// set as the Metadata.
+func (s Signal) Allocation() rf.Allocation {
+	return rf.Allocation{
+		Name:     s.String(),
+		Range:    s.Range(),
+		Metadata: s,
+	}
+}
+
+// SignalOf will return the Signal of an rf.Allocation returned by Signal.Allocation, and false if the
+// rf.Allocation doesn't have one.
+func SignalOf(a rf.Allocation) (Signal, bool) {
+	s, ok := a.Metadata.(Signal)
+	return s, ok
+}
+
+// Signals is a list of GNSS signals.
+type Signals []Signal
+
+// Allocations will return all the Signals as rf.Allocations.
+func (s Signals) Allocations() rf.Allocations {
+	ret := rf.Allocations{}
+	for _, signal := range s {
+		ret = append(ret, signal.Allocation())
+	}
+	return ret
+}
+
+// System will return all the Signals broadcast by the provided System.
+func (s Signals) System(system System) Signals {
+	ret := Signals{}
+	for _, signal := range s {
+		if signal.System == system {
+			ret = append(ret, signal)
+		}
+	}
+	return ret
+}
+
+// Lookup will return the Signal with the provided System and name, such as
+// (GPS, "L1 C/A").
+func (s Signals) Lookup(system System, name string) (Signal, bool) {
+	for _, signal := range s {
+		if signal.System == system && signal.Name == name {
+			return signal, true
+		}
+	}
+	return Signal{}, false
+}
+
+// vim: foldmethod=marker
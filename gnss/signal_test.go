@@ -0,0 +1,79 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package gnss_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/gnss"
+)
+
+func TestCatalog(t *testing.T) {
+	l1, ok := gnss.Catalog.Lookup(gnss.GPS, "L1 C/A")
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*1575420, l1.Carrier)
+	assert.Equal(t, rf.KHz*1023, l1.ChipRate)
+	assert.Equal(t, rf.Range{rf.KHz * 1574397, rf.KHz * 1576443}, l1.Range())
+	assert.Equal(t, "BPSK(1)", l1.Modulation)
+
+	e5a, ok := gnss.Catalog.Lookup(gnss.Galileo, "E5a")
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*1176450, e5a.Carrier)
+	assert.Equal(t, rf.KHz*20460, e5a.Bandwidth)
+
+	_, ok = gnss.Catalog.Lookup(gnss.GPS, "E1")
+	assert.False(t, ok)
+
+	assert.Equal(t, 4, len(gnss.Catalog.System(gnss.GPS)))
+	assert.Equal(t, 29, len(gnss.Catalog.System(gnss.GLONASS)))
+}
+
+func TestGLONASS(t *testing.T) {
+	s, err := gnss.GLONASSL1(0)
+	assert.NoError(t, err)
+	assert.Equal(t, rf.MHz*1602, s.Carrier)
+
+	s, err = gnss.GLONASSL1(-7)
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*1598062.5, s.Carrier)
+
+	s, err = gnss.GLONASSL2(6)
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*1248625, s.Carrier)
+
+	_, err = gnss.GLONASSL1(7)
+	assert.Error(t, err)
+}
+
+func TestAllocations(t *testing.T) {
+	allocations := gnss.Catalog.Allocations().ContainingFrequency(rf.KHz * 1575420)
+	assert.Equal(t, 6, len(allocations))
+	assert.Equal(t, "GPS L1 C/A", allocations.First().Name)
+
+	signal, ok := gnss.SignalOf(allocations.First())
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*1575420, signal.Carrier)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,104 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package ieee802154
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+// ErrInvalidChannel will be returned when a channel number is out of range.
+var ErrInvalidChannel = fmt.Errorf("ieee802154: invalid channel")
+
+// Channel is an IEEE 802.15.4 channel page 0 channel, from 0 to 26. Channel
+// 0 is in the 868MHz band, channels 1 through 10 in the 915MHz band, and
+// channels 11 through 26 in the 2450MHz band.
+type Channel int
+
+// Valid will return true if the channel is between 0 and 26.
+func (c Channel) Valid() bool {
+	return c >= 0 && c <= 26
+}
+
+// Frequency will return the center frequency of the channel.
+func (c Channel) Frequency() rf.Hz {
+	switch {
+	case c == 0:
+		return rf.KHz * 868300
+	case c <= 10:
+		return rf.MHz * rf.Hz(906+2*(int(c)-1))
+	default:
+		return rf.MHz * rf.Hz(2405+5*(int(c)-11))
+	}
+}
+
+// Bandwidth will return the nominal bandwidth of the channel.
+func (c Channel) Bandwidth() rf.Hz {
+	switch {
+	case c == 0:
+		return rf.KHz * 600
+	case c <= 10:
+		return rf.KHz * 1200
+	default:
+		return rf.MHz * 2
+	}
+}
+
+// Range will return the range of frequencies the channel occupies.
+func (c Channel) Range() rf.Range {
+	half := c.Bandwidth() / 2
+	return rf.Range{-half, half}.Add(c.Frequency())
+}
+
+// ChannelForFrequency will return the channel whose Range contains the
+// provided frequency.
+func ChannelForFrequency(freq rf.Hz) (Channel, error) {
+	for c := Channel(0); c.Valid(); c++ {
+		if c.Range().ContainsFrequency(freq) {
+			return c, nil
+		}
+	}
+	return 0, ErrInvalidChannel
+}
+
+// Channels are all 27 channels as rf.Allocations, with the Channel set as
+// the Metadata.
+var Channels = func() rf.Allocations {
+	ret := rf.Allocations{}
+	for c := Channel(0); c.Valid(); c++ {
+		ret = append(ret, rf.Allocation{
+			Name:     fmt.Sprintf("802.15.4 %d", c),
+			Range:    c.Range(),
+			Metadata: c,
+		})
+	}
+	return ret
+}()
+
+// ChannelOf will return the Channel of an rf.Allocation from Channels, and
+// false if the rf.Allocation doesn't have one.
+func ChannelOf(a rf.Allocation) (Channel, bool) {
+	c, ok := a.Metadata.(Channel)
+	return c, ok
+}
+
+// vim: foldmethod=marker
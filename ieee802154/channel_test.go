@@ -0,0 +1,52 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package ieee802154_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/ieee802154"
+)
+
+func TestChannels(t *testing.T) {
+	assert.Equal(t, rf.KHz*868300, ieee802154.Channel(0).Frequency())
+	assert.Equal(t, rf.MHz*906, ieee802154.Channel(1).Frequency())
+	assert.Equal(t, rf.MHz*924, ieee802154.Channel(10).Frequency())
+	assert.Equal(t, rf.MHz*2405, ieee802154.Channel(11).Frequency())
+	assert.Equal(t, rf.MHz*2480, ieee802154.Channel(26).Frequency())
+	assert.Equal(t, 27, len(ieee802154.Channels))
+
+	channel, ok := ieee802154.ChannelOf(ieee802154.Channels[15])
+	assert.True(t, ok)
+	assert.Equal(t, ieee802154.Channel(15), channel)
+
+	c, err := ieee802154.ChannelForFrequency(rf.KHz * 2425500)
+	assert.NoError(t, err)
+	assert.Equal(t, ieee802154.Channel(15), c)
+
+	_, err = ieee802154.ChannelForFrequency(rf.MHz * 2428)
+	assert.Error(t, err)
+}
+
+// vim: foldmethod=marker
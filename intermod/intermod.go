@@ -0,0 +1,216 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package intermod
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+// Term is a single transmitter's contribution to a Product.
+type Term struct {
+	// Transmitter is the index of the transmitter.
+	Transmitter int
+
+	// Coefficient the transmitter's frequency is multiplied by, which may
+	// be negative.
+	Coefficient int
+}
+
+// Product is a single intermodulation product.
+type Product struct {
+	// Frequency of the product.
+	Frequency rf.Hz
+
+	// Order of the product, which is the sum of the absolute values of the
+	// coefficients of the Terms.
+	Order int
+
+	// Terms are the contributing transmitters, in order of index. A single
+	// Term is a harmonic of that transmitter.
+	Terms []Term
+}
+
+// String will return the product in the usual notation, with transmitters
+// numbered from 1, such as "2f1-f2".
+func (p Product) String() string {
+	var b strings.Builder
+	for i, term := range p.Terms {
+		c := term.Coefficient
+		switch {
+		case c < 0:
+			b.WriteString("-")
+			c = -c
+		case i > 0:
+			b.WriteString("+")
+		}
+		if c != 1 {
+			fmt.Fprintf(&b, "%d", c)
+		}
+		fmt.Fprintf(&b, "f%d", term.Transmitter+1)
+	}
+	return b.String()
+}
+
+// Range will return the range of frequencies occupied by the product, if
+// every transmitter occupies the provided bandwidth.
+func (p Product) Range(bandwidth rf.Hz) rf.Range {
+	half := bandwidth * rf.Hz(p.Order) / 2
+	return rf.Range{-half, half}.Add(p.Frequency)
+}
+
+// walk will call fn with every product of exactly the provided order. To
+// avoid returning each product twice, with every sign flipped, the first
+// Term always has a positive coefficient, and the signs are flipped when the
+// frequency is negative.
+func walk(
+	transmitters []rf.Hz,
+	start, remaining int,
+	terms []Term,
+	freq rf.Hz,
+	fn func([]Term, rf.Hz),
+) {
+	if remaining == 0 {
+		fn(terms, freq)
+		return
+	}
+	for i := start; i < len(transmitters); i++ {
+		for c := 1; c <= remaining; c++ {
+			for _, sign := range []int{1, -1} {
+				if len(terms) == 0 && sign < 0 {
+					continue
+				}
+				k := sign * c
+				walk(
+					transmitters, i+1, remaining-c,
+					append(terms, Term{Transmitter: i, Coefficient: k}),
+					freq+rf.Hz(k)*transmitters[i],
+					fn,
+				)
+			}
+		}
+	}
+}
+
+// each will call fn with every Product from 2nd to maxOrder order.
+func each(transmitters []rf.Hz, maxOrder int, fn func(Product)) {
+	for order := 2; order <= maxOrder; order++ {
+		order := order
+		walk(transmitters, 0, order, make([]Term, 0, order), 0, func(terms []Term, freq rf.Hz) {
+			if freq == 0 {
+				return
+			}
+			p := Product{
+				Frequency: freq,
+				Order:     order,
+				Terms:     make([]Term, len(terms)),
+			}
+			copy(p.Terms, terms)
+			if freq < 0 {
+				p.Frequency = -freq
+				for i := range p.Terms {
+					p.Terms[i].Coefficient = -p.Terms[i].Coefficient
+				}
+			}
+			fn(p)
+		})
+	}
+}
+
+// Products will return every intermodulation product of the transmitters,
+// from 2nd to maxOrder order, including harmonics, sorted by frequency.
+// Products at DC are omitted.
+func Products(transmitters []rf.Hz, maxOrder int) []Product {
+	ret := []Product{}
+	each(transmitters, maxOrder, func(p Product) {
+		ret = append(ret, p)
+	})
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Frequency < ret[j].Frequency
+	})
+	return ret
+}
+
+// Hit is an intermodulation Product that lands inside a receiver's
+// passband.
+type Hit struct {
+	Product
+
+	// Receiver is the index of the receiver.
+	Receiver int
+}
+
+// Check will return every intermodulation product of the transmitters,
+// from 2nd to maxOrder order, that lands inside one of the receivers'
+// passbands, lowest order first, then by frequency.
+//
+// If bandwidth is zero, a product hits a receiver if its Frequency is
+// contained in the receiver's Range. Otherwise, every transmitter is
+// assumed to occupy the provided bandwidth, and a product hits a receiver if
+// its Range overlaps the receiver's Range.
+func Check(transmitters []rf.Hz, bandwidth rf.Hz, receivers []rf.Range, maxOrder int) []Hit {
+	// Sort the receivers by their lower edge, so that only those that
+	// start below the product's upper edge need to be checked.
+	order := make([]int, len(receivers))
+	widest := rf.Hz(0)
+	for i, r := range receivers {
+		order[i] = i
+		if w := r[1] - r[0]; w > widest {
+			widest = w
+		}
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return receivers[order[i]][0] < receivers[order[j]][0]
+	})
+
+	ret := []Hit{}
+	each(transmitters, maxOrder, func(p Product) {
+		pr := p.Range(bandwidth)
+		first := sort.Search(len(order), func(i int) bool {
+			return receivers[order[i]][0] >= pr[0]-widest
+		})
+		for _, i := range order[first:] {
+			r := receivers[i]
+			if r[0] > pr[1] {
+				break
+			}
+			hit := r.ContainsFrequency(p.Frequency)
+			if bandwidth != 0 {
+				hit = r.Overlaps(pr)
+			}
+			if hit {
+				ret = append(ret, Hit{Product: p, Receiver: i})
+			}
+		}
+	})
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Order != ret[j].Order {
+			return ret[i].Order < ret[j].Order
+		}
+		return ret[i].Frequency < ret[j].Frequency
+	})
+	return ret
+}
+
+// vim: foldmethod=marker
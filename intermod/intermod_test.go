@@ -0,0 +1,81 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package intermod_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/intermod"
+)
+
+func TestProducts(t *testing.T) {
+	tx := []rf.Hz{rf.MHz * 146, rf.MHz * 147}
+	products := intermod.Products(tx, 3)
+
+	names := map[string]rf.Hz{}
+	for _, p := range products {
+		names[p.String()] = p.Frequency
+	}
+	assert.Equal(t, rf.MHz*1, names["-f1+f2"])
+	assert.Equal(t, rf.MHz*293, names["f1+f2"])
+	assert.Equal(t, rf.MHz*292, names["2f1"])
+	assert.Equal(t, rf.MHz*145, names["2f1-f2"])
+	assert.Equal(t, rf.MHz*148, names["-f1+2f2"])
+	assert.Equal(t, rf.MHz*441, names["3f2"])
+
+	// 2nd order: 2f1, 2f2, f1+f2, f2-f1. 3rd order: 3f1, 3f2, 2f1+f2,
+	// 2f1-f2, f1+2f2, -f1+2f2.
+	assert.Equal(t, 10, len(products))
+	for i := 1; i < len(products); i++ {
+		assert.True(t, products[i-1].Frequency <= products[i].Frequency)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tx := []rf.Hz{rf.KHz * 146520, rf.KHz * 146940, rf.KHz * 147300}
+	rx := []rf.Range{
+		{rf.KHz * 146095, rf.KHz * 146105},
+		{rf.KHz * 147715, rf.KHz * 147725},
+		{rf.KHz * 446000, rf.KHz * 446100},
+	}
+
+	hits := intermod.Check(tx, 0, rx, 3)
+	assert.Equal(t, 2, len(hits))
+	assert.Equal(t, "2f1-f2", hits[0].String())
+	assert.Equal(t, 0, hits[0].Receiver)
+	assert.Equal(t, 3, hits[0].Order)
+	assert.Equal(t, "-f1+f2+f3", hits[1].String())
+	assert.Equal(t, 1, hits[1].Receiver)
+
+	// 3f1-2f2 lands 5kHz below the receiver, which only hits once the
+	// transmitters' bandwidth is taken into account.
+	rx = []rf.Range{{rf.KHz * 145685, rf.KHz * 145690}}
+	assert.Equal(t, 0, len(intermod.Check(tx, 0, rx, 5)))
+	hits = intermod.Check(tx, rf.KHz*16, rx, 5)
+	assert.Equal(t, 1, len(hits))
+	assert.Equal(t, "3f1-2f2", hits[0].String())
+	assert.Equal(t, 5, hits[0].Order)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,197 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package link
+
+import (
+	"fmt"
+	"math"
+
+	"hz.tools/rf"
+)
+
+// ErrInvalidStep will be returned when a Sweep is requested with a step
+// that isn't positive.
+var ErrInvalidStep = fmt.Errorf("link: sweep step must be positive")
+
+// FreeSpacePathLoss will return the loss between two isotropic antennas
+// the provided distance apart, in meters, which is (4πd/λ)² as a ratio.
+func FreeSpacePathLoss(distance float64, freq rf.Hz) rf.DB {
+	return rf.DB(20 * math.Log10(4*math.Pi*distance/freq.Wavelength()))
+}
+
+// Friis will return the power received over free space, given the
+// transmitted power, the gain of each antenna, and the distance between
+// them in meters.
+func Friis(tx rf.DBm, txGain, rxGain rf.DB, distance float64, freq rf.Hz) rf.DBm {
+	return tx.Add(txGain).Add(rxGain).Add(-FreeSpacePathLoss(distance, freq))
+}
+
+// Item is a single contribution to a Result. Unlike a Budget, losses are
+// given as a negative Gain, so every Item can be added up.
+type Item struct {
+	Name string
+	Gain rf.DB
+}
+
+// Loss is a named loss in a Budget, given as a positive number.
+type Loss struct {
+	Name string
+	Loss rf.DB
+}
+
+// Budget is a link budget between a transmitter and receiver. Losses are
+// given as positive numbers, the way they're usually written down.
+type Budget struct {
+	// TXPower is the power out of the transmitter.
+	TXPower rf.DBm
+
+	// TXLoss is the loss in the cable and connectors between the
+	// transmitter and its antenna.
+	TXLoss rf.DB
+
+	// TXGain is the gain of the transmitting antenna, in dBi.
+	TXGain rf.DB
+
+	// Distance between the antennas, in meters.
+	Distance float64
+
+	// Losses are any other losses along the path, such as polarization
+	// mismatch or atmospheric absorption, applied after the path loss.
+	Losses []Loss
+
+	// RXGain is the gain of the receiving antenna, in dBi.
+	RXGain rf.DB
+
+	// RXLoss is the loss in the cable and connectors between the
+	// receiving antenna and the receiver.
+	RXLoss rf.DB
+
+	// FadeMargin is the margin held in reserve for fading.
+	FadeMargin rf.DB
+
+	// Sensitivity is the weakest signal the receiver can use.
+	Sensitivity rf.DBm
+}
+
+// Result is a Budget evaluated at a single frequency.
+type Result struct {
+	// Frequency the Budget was evaluated at.
+	Frequency rf.Hz
+
+	// TXPower is the power out of the transmitter.
+	TXPower rf.DBm
+
+	// Items are each gain and loss between the transmitter and receiver,
+	// in order.
+	Items []Item
+
+	// EIRP is the effective isotropic radiated power.
+	EIRP rf.DBm
+
+	// PathLoss is the free-space path loss.
+	PathLoss rf.DB
+
+	// Received is the power at the receiver.
+	Received rf.DBm
+
+	// FadeMargin is the margin held in reserve for fading.
+	FadeMargin rf.DB
+
+	// Sensitivity is the weakest signal the receiver can use.
+	Sensitivity rf.DBm
+
+	// Margin is how far the Received power is above the Sensitivity, after
+	// the FadeMargin is set aside.
+	Margin rf.DB
+}
+
+// Closes will return true if the link has a non-negative Margin.
+func (r Result) Closes() bool {
+	return r.Margin >= 0
+}
+
+// At will evaluate the Budget at the provided frequency.
+func (b Budget) At(freq rf.Hz) Result {
+	pathLoss := FreeSpacePathLoss(b.Distance, freq)
+
+	ret := Result{
+		Frequency: freq,
+		TXPower:   b.TXPower,
+		Items: []Item{
+			{Name: "TX cable loss", Gain: -b.TXLoss},
+			{Name: "TX antenna gain", Gain: b.TXGain},
+			{Name: "Free-space path loss", Gain: -pathLoss},
+		},
+		EIRP:        b.TXPower.Add(b.TXGain - b.TXLoss),
+		PathLoss:    pathLoss,
+		FadeMargin:  b.FadeMargin,
+		Sensitivity: b.Sensitivity,
+	}
+	for _, loss := range b.Losses {
+		ret.Items = append(ret.Items, Item{Name: loss.Name, Gain: -loss.Loss})
+	}
+	ret.Items = append(ret.Items,
+		Item{Name: "RX antenna gain", Gain: b.RXGain},
+		Item{Name: "RX cable loss", Gain: -b.RXLoss},
+	)
+
+	ret.Received = b.TXPower
+	for _, item := range ret.Items {
+		ret.Received = ret.Received.Add(item.Gain)
+	}
+	ret.Margin = ret.Received.Sub(b.Sensitivity) - b.FadeMargin
+	return ret
+}
+
+// Sweep will evaluate the Budget across the Range, every step from the
+// lowest frequency, including both ends of the Range.
+func (b Budget) Sweep(r rf.Range, step rf.Hz) ([]Result, error) {
+	if step <= 0 {
+		return nil, ErrInvalidStep
+	}
+	if r[1] < r[0] {
+		r = rf.Range{r[1], r[0]}
+	}
+	var (
+		n   = int(math.Floor(float64((r[1]-r[0])/step)+1e-9)) + 1
+		ret = make([]Result, 0, n+1)
+	)
+	for i := 0; i < n; i++ {
+		ret = append(ret, b.At(r[0]+rf.Hz(i)*step))
+	}
+	if last := ret[len(ret)-1].Frequency; last < r[1]-step*1e-9 {
+		ret = append(ret, b.At(r[1]))
+	}
+	return ret, nil
+}
+
+// Worst will return the Result with the least Margin.
+func Worst(results []Result) Result {
+	ret := Result{Margin: rf.DB(math.Inf(1))}
+	for _, result := range results {
+		if result.Margin < ret.Margin {
+			ret = result
+		}
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
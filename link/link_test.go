@@ -0,0 +1,101 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package link_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/link"
+)
+
+func TestFreeSpacePathLoss(t *testing.T) {
+	// 20log(d km) + 20log(f MHz) + 32.45
+	assert.InDelta(t, 100.05, float64(link.FreeSpacePathLoss(1000, rf.MHz*2400)), 0.01)
+	assert.InDelta(t, 75.96, float64(link.FreeSpacePathLoss(1000, rf.MHz*150)), 0.01)
+
+	// Doubling the distance or frequency costs 6dB.
+	assert.InDelta(t, 6.02,
+		float64(link.FreeSpacePathLoss(2000, rf.MHz*2400)-link.FreeSpacePathLoss(1000, rf.MHz*2400)),
+		0.01)
+}
+
+func TestFriis(t *testing.T) {
+	rx := link.Friis(20, 6, 3, 1000, rf.MHz*2400)
+	assert.InDelta(t, -71.05, float64(rx), 0.01)
+}
+
+func TestBudget(t *testing.T) {
+	b := link.Budget{
+		TXPower:     rf.Watts(5).DBm(),
+		TXLoss:      2,
+		TXGain:      6,
+		Distance:    20000,
+		Losses:      []link.Loss{{Name: "Polarization", Loss: 3}},
+		RXGain:      3,
+		RXLoss:      1,
+		FadeMargin:  10,
+		Sensitivity: -120,
+	}
+
+	r := b.At(rf.MHz * 146)
+	assert.Equal(t, rf.MHz*146, r.Frequency)
+	assert.Equal(t, 6, len(r.Items))
+	assert.Equal(t, link.Item{Name: "Polarization", Gain: -3}, r.Items[3])
+	assert.InDelta(t, 40.99, float64(r.EIRP), 0.01)
+	assert.InDelta(t, 101.76, float64(r.PathLoss), 0.01)
+	assert.InDelta(t, -61.77, float64(r.Received), 0.01)
+	assert.InDelta(t, 48.23, float64(r.Margin), 0.01)
+	assert.True(t, r.Closes())
+
+	b.Sensitivity = -50
+	assert.False(t, b.At(rf.MHz*146).Closes())
+}
+
+func TestSweep(t *testing.T) {
+	b := link.Budget{TXPower: 30, Distance: 1000, Sensitivity: -100}
+
+	results, err := b.Sweep(rf.Range{rf.MHz * 144, rf.MHz * 148}, rf.MHz)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(results))
+	assert.Equal(t, rf.MHz*144, results[0].Frequency)
+	assert.Equal(t, rf.MHz*148, results[4].Frequency)
+	assert.True(t, results[0].Margin > results[4].Margin)
+	assert.Equal(t, results[4], link.Worst(results))
+
+	results, err = b.Sweep(rf.Range{rf.MHz * 144, rf.MHz * 148}, rf.MHz*3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, rf.MHz*148, results[2].Frequency)
+
+	results, err = b.Sweep(rf.Range{rf.MHz * 200, rf.MHz * 100}, rf.MHz)
+	assert.NoError(t, err)
+	assert.Equal(t, 101, len(results))
+	assert.Equal(t, rf.MHz*100, results[0].Frequency)
+	assert.Equal(t, rf.MHz*200, results[100].Frequency)
+
+	_, err = b.Sweep(rf.Range{rf.MHz * 144, rf.MHz * 148}, 0)
+	assert.Equal(t, link.ErrInvalidStep, err)
+}
+
+// vim: foldmethod=marker
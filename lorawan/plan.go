@@ -0,0 +1,268 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package lorawan
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+var (
+	// ErrInvalidChannel will be returned when a channel does not conform to
+	// the channel plan.
+	ErrInvalidChannel = fmt.Errorf("lorawan: channel not valid for region")
+
+	// ErrUnknownSubBand will be returned when a sub-band does not exist in
+	// the channel plan.
+	ErrUnknownSubBand = fmt.Errorf("lorawan: unknown sub-band")
+
+	// ErrUnknownGroup will be returned when an AS923 group does not exist.
+	ErrUnknownGroup = fmt.Errorf("lorawan: unknown AS923 group")
+)
+
+// Direction is the direction a channel is used in.
+type Direction int
+
+const (
+	// Uplink channels are used to transmit from an end-device to a gateway.
+	Uplink Direction = iota
+
+	// Downlink channels are used to transmit from a gateway to an
+	// end-device.
+	Downlink
+)
+
+// String will return "uplink" or "downlink".
+func (d Direction) String() string {
+	switch d {
+	case Uplink:
+		return "uplink"
+	case Downlink:
+		return "downlink"
+	default:
+		return "unknown"
+	}
+}
+
+// Channel is a single LoRaWAN channel.
+type Channel struct {
+	// Index is the channel index, as used in the LinkADRReq ChMask.
+	Index int
+
+	// Direction the channel is used in.
+	Direction Direction
+
+	// Frequency is the center frequency of the channel.
+	Frequency rf.Hz
+
+	// Bandwidth is the LoRa bandwidth of the channel, such as 125kHz.
+	Bandwidth rf.Hz
+}
+
+// Range will return the range of frequencies occupied by this channel.
+func (c Channel) Range() rf.Range {
+	half := c.Bandwidth / 2
+	return rf.Range{-half, half}.Add(c.Frequency)
+}
+
+// Allocation will return the channel as an rf.Allocation, with the Channel
+// set as the Metadata.
+func (c Channel) Allocation() rf.Allocation {
+	return rf.Allocation{
+		Name:     fmt.Sprintf("%s %d", c.Direction, c.Index),
+		Range:    c.Range(),
+		Metadata: c,
+	}
+}
+
+// ChannelOf will return the Channel of an rf.Allocation returned by Channel.Allocation, and false if the
+// rf.Allocation doesn't have one.
+func ChannelOf(a rf.Allocation) (Channel, bool) {
+	c, ok := a.Metadata.(Channel)
+	return c, ok
+}
+
+// Channels is a list of LoRaWAN channels.
+type Channels []Channel
+
+// Allocations will return all the Channels as rf.Allocations.
+func (c Channels) Allocations() rf.Allocations {
+	ret := rf.Allocations{}
+	for _, channel := range c {
+		ret = append(ret, channel.Allocation())
+	}
+	return ret
+}
+
+// DutyCycle is the maximum fraction of time a device may transmit within a
+// sub-band, such as 0.01 for 1%.
+type DutyCycle float64
+
+// Plan is a LoRaWAN Regional Parameters channel plan.
+type Plan struct {
+	// Name of the region, such as "EU868".
+	Name string
+
+	// Band is the range of frequencies LoRaWAN may operate in within this
+	// region.
+	Band rf.Range
+
+	// DefaultChannels are the channels every end-device must implement, and
+	// that are used when joining. On fixed channel plans (such as US915)
+	// this is every uplink channel.
+	DefaultChannels Channels
+
+	// Uplink are the uplink channels. On dynamic channel plans (such as
+	// EU868), further channels may be added by the network anywhere within
+	// Band, and only the DefaultChannels are listed here.
+	Uplink Channels
+
+	// Downlink are the RX1 downlink channels on fixed channel plans. On
+	// dynamic channel plans, RX1 is sent on the uplink frequency, and this
+	// will be empty.
+	Downlink Channels
+
+	// RX2 is the default RX2 receive window channel.
+	RX2 Channel
+
+	// RX2DataRate is the default RX2 data rate.
+	RX2DataRate int
+
+	// DutyCycle are the regulatory sub-bands, each Allocation having a
+	// DutyCycle as its Metadata, which can be read with DutyCycleOf. This will be empty for regions which are
+	// not duty cycle limited.
+	DutyCycle rf.Allocations
+
+	// SubBandSize is the number of 125kHz uplink channels in each sub-band
+	// on fixed channel plans, or 0 on dynamic plans.
+	SubBandSize int
+}
+
+// Fixed will return true if the channel plan has a fixed set of uplink
+// channels, such as US915 or AU915.
+func (p Plan) Fixed() bool {
+	return len(p.Downlink) > 0
+}
+
+// RX1 will return the channel the RX1 downlink will be sent on, given the
+// uplink channel.
+func (p Plan) RX1(uplink Channel) (Channel, error) {
+	if !p.Fixed() {
+		downlink := uplink
+		downlink.Direction = Downlink
+		return downlink, nil
+	}
+	for _, channel := range p.Uplink {
+		if channel.Index == uplink.Index {
+			return p.Downlink[channel.Index%len(p.Downlink)], nil
+		}
+	}
+	return Channel{}, ErrInvalidChannel
+}
+
+// SubBand will return the uplink channels in the 1-indexed sub-band, which
+// are the SubBandSize 125kHz channels, followed by the matching 500kHz
+// channel, if one exists.
+func (p Plan) SubBand(n int) (Channels, error) {
+	if p.SubBandSize == 0 || n < 1 {
+		return nil, ErrUnknownSubBand
+	}
+
+	var (
+		narrow = Channels{}
+		wide   = Channels{}
+	)
+	for _, channel := range p.Uplink {
+		if channel.Bandwidth == p.Uplink[0].Bandwidth {
+			narrow = append(narrow, channel)
+		} else {
+			wide = append(wide, channel)
+		}
+	}
+
+	start := (n - 1) * p.SubBandSize
+	if start+p.SubBandSize > len(narrow) {
+		return nil, ErrUnknownSubBand
+	}
+	ret := append(Channels{}, narrow[start:start+p.SubBandSize]...)
+	if n-1 < len(wide) {
+		ret = append(ret, wide[n-1])
+	}
+	return ret, nil
+}
+
+// Validate will check that the provided channel is permitted by the plan.
+// On fixed channel plans the channel must match one of the Uplink or
+// Downlink channels. On dynamic plans, the channel must fall entirely within
+// Band, and within a single duty cycle sub-band if the region has any.
+func (p Plan) Validate(channel Channel) error {
+	if p.RX2.Frequency == channel.Frequency && p.RX2.Bandwidth == channel.Bandwidth {
+		return nil
+	}
+
+	if p.Fixed() {
+		for _, c := range append(append(Channels{}, p.Uplink...), p.Downlink...) {
+			if c.Frequency == channel.Frequency && c.Bandwidth == channel.Bandwidth {
+				return nil
+			}
+		}
+		return ErrInvalidChannel
+	}
+
+	r := channel.Range()
+	if !p.Band.ContainsRange(r) {
+		return ErrInvalidChannel
+	}
+	if len(p.DutyCycle) == 0 {
+		return nil
+	}
+	for _, subBand := range p.DutyCycle {
+		if subBand.Range.ContainsRange(r) {
+			return nil
+		}
+	}
+	return ErrInvalidChannel
+}
+
+// DutyCycleLimit will return the duty cycle limit for the provided frequency,
+// or 1 if the frequency is not in a duty cycle limited sub-band.
+func (p Plan) DutyCycleLimit(freq rf.Hz) DutyCycle {
+	for _, subBand := range p.DutyCycle.ContainingFrequency(freq) {
+		if limit, ok := DutyCycleOf(subBand); ok {
+			return limit
+		}
+	}
+	return 1
+}
+
+// Allocations will return the plan's uplink, downlink and RX2 channels as
+// rf.Allocations.
+func (p Plan) Allocations() rf.Allocations {
+	ret := rf.Allocations{}
+	ret = append(ret, p.Uplink.Allocations()...)
+	ret = append(ret, p.Downlink.Allocations()...)
+	rx2 := p.RX2.Allocation()
+	rx2.Name = "RX2"
+	return append(ret, rx2)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,121 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package lorawan_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/lorawan"
+)
+
+func TestUS915(t *testing.T) {
+	assert.Equal(t, 72, len(lorawan.US915.Uplink))
+	assert.Equal(t, 8, len(lorawan.US915.Downlink))
+	assert.Equal(t, rf.KHz*914900, lorawan.US915.Uplink[63].Frequency)
+	assert.Equal(t, rf.KHz*914200, lorawan.US915.Uplink[71].Frequency)
+	assert.Equal(t, rf.KHz*927500, lorawan.US915.Downlink[7].Frequency)
+
+	rx1, err := lorawan.US915.RX1(lorawan.US915.Uplink[10])
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*924500, rx1.Frequency)
+	assert.Equal(t, rf.KHz*500, rx1.Bandwidth)
+
+	subBand, err := lorawan.US915.SubBand(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, len(subBand))
+	assert.Equal(t, rf.KHz*903900, subBand[0].Frequency)
+	assert.Equal(t, rf.KHz*904600, subBand[8].Frequency)
+
+	_, err = lorawan.US915.SubBand(9)
+	assert.Error(t, err)
+	_, err = lorawan.EU868.SubBand(1)
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, lorawan.US915.Validate(lorawan.Channel{
+		Frequency: rf.KHz * 904300, Bandwidth: rf.KHz * 125,
+	}))
+	assert.Error(t, lorawan.US915.Validate(lorawan.Channel{
+		Frequency: rf.KHz * 904350, Bandwidth: rf.KHz * 125,
+	}))
+
+	assert.NoError(t, lorawan.EU868.Validate(lorawan.Channel{
+		Frequency: rf.KHz * 867100, Bandwidth: rf.KHz * 125,
+	}))
+	assert.NoError(t, lorawan.EU868.Validate(lorawan.EU868.RX2))
+	// Straddles the g1/g2 sub-band boundary.
+	assert.Error(t, lorawan.EU868.Validate(lorawan.Channel{
+		Frequency: rf.KHz * 868650, Bandwidth: rf.KHz * 125,
+	}))
+	assert.Error(t, lorawan.EU868.Validate(lorawan.Channel{
+		Frequency: rf.KHz * 915000, Bandwidth: rf.KHz * 125,
+	}))
+}
+
+func TestDutyCycle(t *testing.T) {
+	assert.Equal(t, lorawan.DutyCycle(0.01), lorawan.EU868.DutyCycleLimit(rf.KHz*868100))
+	assert.Equal(t, lorawan.DutyCycle(0.1), lorawan.EU868.DutyCycleLimit(rf.KHz*869525))
+	assert.Equal(t, lorawan.DutyCycle(1), lorawan.US915.DutyCycleLimit(rf.KHz*904300))
+
+	limit, ok := lorawan.DutyCycleOf(lorawan.EU868.DutyCycle.ContainingFrequency(rf.KHz * 869525).First())
+	assert.True(t, ok)
+	assert.Equal(t, lorawan.DutyCycle(0.1), limit)
+}
+
+func TestAS923(t *testing.T) {
+	assert.Equal(t, rf.KHz*923200, lorawan.AS923.DefaultChannels[0].Frequency)
+
+	plan, err := lorawan.AS923Group(1)
+	assert.NoError(t, err)
+	assert.Equal(t, lorawan.AS923, plan)
+	assert.Equal(t, "AS923-1", plan.Name)
+
+	plan, err = lorawan.AS923Group(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "AS923-2", plan.Name)
+	assert.Equal(t, rf.KHz*921400, plan.DefaultChannels[0].Frequency)
+
+	plan, err = lorawan.AS923Group(4)
+	assert.NoError(t, err)
+	assert.Equal(t, "AS923-4", plan.Name)
+	assert.Equal(t, rf.KHz*917300, plan.DefaultChannels[0].Frequency)
+
+	for _, group := range []int{0, 5, -1} {
+		_, err = lorawan.AS923Group(group)
+		assert.Equal(t, lorawan.ErrUnknownGroup, err)
+	}
+}
+
+func TestPlanAllocations(t *testing.T) {
+	for _, plan := range lorawan.Plans {
+		for _, allocation := range plan.Allocations() {
+			assert.True(t, plan.Band.ContainsRange(allocation.Range), "%s %s", plan.Name, allocation)
+			_, ok := lorawan.ChannelOf(allocation)
+			assert.True(t, ok)
+		}
+	}
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,226 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package lorawan
+
+import (
+	"hz.tools/rf"
+)
+
+// channels will create count channels, starting at the provided channel
+// index and frequency, stepping by step.
+func channels(
+	direction Direction,
+	index int,
+	start rf.Hz,
+	step rf.Hz,
+	count int,
+	bandwidth rf.Hz,
+) Channels {
+	ret := Channels{}
+	for i := 0; i < count; i++ {
+		ret = append(ret, Channel{
+			Index:     index + i,
+			Direction: direction,
+			Frequency: start + step*rf.Hz(i),
+			Bandwidth: bandwidth,
+		})
+	}
+	return ret
+}
+
+// uplinks will create 125kHz uplink channels at the provided frequencies.
+func uplinks(freqs ...rf.Hz) Channels {
+	ret := Channels{}
+	for i, freq := range freqs {
+		ret = append(ret, Channel{
+			Index:     i,
+			Direction: Uplink,
+			Frequency: freq,
+			Bandwidth: bw125,
+		})
+	}
+	return ret
+}
+
+// dutyCycle will create a duty cycle sub-band Allocation.
+func dutyCycle(low, high rf.Hz, limit DutyCycle) rf.Allocation {
+	return rf.Allocation{
+		Name:     "Duty Cycle",
+		Range:    rf.Range{low, high},
+		Metadata: limit,
+	}
+}
+
+// DutyCycleOf will return the DutyCycle of a sub-band from a Plan's DutyCycle, and false if the
+// rf.Allocation doesn't have one.
+func DutyCycleOf(a rf.Allocation) (DutyCycle, bool) {
+	limit, ok := a.Metadata.(DutyCycle)
+	return limit, ok
+}
+
+// join will concatenate a number of Channels together.
+func join(channels ...Channels) Channels {
+	ret := Channels{}
+	for _, c := range channels {
+		ret = append(ret, c...)
+	}
+	return ret
+}
+
+var (
+	bw125 = rf.KHz * 125
+	bw500 = rf.KHz * 500
+)
+
+var (
+	// EU868 is the 863-870MHz European channel plan.
+	EU868 = Plan{
+		Name:            "EU868",
+		Band:            rf.Range{rf.MHz * 863, rf.MHz * 870},
+		DefaultChannels: uplinks(rf.KHz*868100, rf.KHz*868300, rf.KHz*868500),
+		Uplink:          uplinks(rf.KHz*868100, rf.KHz*868300, rf.KHz*868500),
+		RX2:             Channel{Direction: Downlink, Frequency: rf.KHz * 869525, Bandwidth: bw125},
+		RX2DataRate:     0,
+		DutyCycle: rf.Allocations{
+			dutyCycle(rf.KHz*863000, rf.KHz*865000, 0.001),
+			dutyCycle(rf.KHz*865000, rf.KHz*868000, 0.01),
+			dutyCycle(rf.KHz*868000, rf.KHz*868600, 0.01),
+			dutyCycle(rf.KHz*868700, rf.KHz*869200, 0.001),
+			dutyCycle(rf.KHz*869400, rf.KHz*869650, 0.1),
+			dutyCycle(rf.KHz*869700, rf.KHz*870000, 0.01),
+		},
+	}
+
+	// US915 is the 902-928MHz United States channel plan, with 64 125kHz
+	// uplink channels, 8 500kHz uplink channels and 8 500kHz downlink
+	// channels.
+	US915 = fixedPlan("US915", rf.Range{rf.MHz * 902, rf.MHz * 928}, rf.KHz*902300, rf.KHz*903000)
+
+	// AU915 is the 915-928MHz Australian channel plan, with 64 125kHz
+	// uplink channels, 8 500kHz uplink channels and 8 500kHz downlink
+	// channels.
+	AU915 = fixedPlan("AU915", rf.Range{rf.MHz * 915, rf.MHz * 928}, rf.KHz*915200, rf.KHz*915900)
+
+	// CN470 is the 470-510MHz Chinese channel plan, with 96 uplink channels
+	// and 48 downlink channels.
+	CN470 = Plan{
+		Name:            "CN470",
+		Band:            rf.Range{rf.MHz * 470, rf.MHz * 510},
+		DefaultChannels: channels(Uplink, 0, rf.KHz*470300, rf.KHz*200, 96, bw125),
+		Uplink:          channels(Uplink, 0, rf.KHz*470300, rf.KHz*200, 96, bw125),
+		Downlink:        channels(Downlink, 0, rf.KHz*500300, rf.KHz*200, 48, bw125),
+		RX2:             Channel{Direction: Downlink, Frequency: rf.KHz * 505300, Bandwidth: bw125},
+		RX2DataRate:     0,
+		SubBandSize:     8,
+	}
+
+	// AS923 is the 915-928MHz Asian channel plan (AS923-1). Use
+	// AS923Group to get the plans for AS923-2, AS923-3 and AS923-4.
+	AS923 = as923("AS923-1", 0)
+
+	// KR920 is the 920-923MHz South Korean channel plan.
+	KR920 = Plan{
+		Name:            "KR920",
+		Band:            rf.Range{rf.KHz * 920900, rf.KHz * 923300},
+		DefaultChannels: uplinks(rf.KHz*922100, rf.KHz*922300, rf.KHz*922500),
+		Uplink:          uplinks(rf.KHz*922100, rf.KHz*922300, rf.KHz*922500),
+		RX2:             Channel{Direction: Downlink, Frequency: rf.KHz * 921900, Bandwidth: bw125},
+		RX2DataRate:     0,
+	}
+
+	// IN865 is the 865-867MHz Indian channel plan.
+	IN865 = Plan{
+		Name:            "IN865",
+		Band:            rf.Range{rf.MHz * 865, rf.MHz * 867},
+		DefaultChannels: uplinks(rf.KHz*865062.5, rf.KHz*865402.5, rf.KHz*865985),
+		Uplink:          uplinks(rf.KHz*865062.5, rf.KHz*865402.5, rf.KHz*865985),
+		RX2:             Channel{Direction: Downlink, Frequency: rf.KHz * 866550, Bandwidth: bw125},
+		RX2DataRate:     2,
+	}
+
+	// EU433 is the 433MHz European channel plan.
+	EU433 = Plan{
+		Name:            "EU433",
+		Band:            rf.Range{rf.KHz * 433050, rf.KHz * 434790},
+		DefaultChannels: uplinks(rf.KHz*433175, rf.KHz*433375, rf.KHz*433575),
+		Uplink:          uplinks(rf.KHz*433175, rf.KHz*433375, rf.KHz*433575),
+		RX2:             Channel{Direction: Downlink, Frequency: rf.KHz * 434665, Bandwidth: bw125},
+		RX2DataRate:     0,
+		DutyCycle: rf.Allocations{
+			dutyCycle(rf.KHz*433050, rf.KHz*434790, 0.01),
+		},
+	}
+
+	// Plans are all the channel plans in this package.
+	Plans = []Plan{EU868, US915, AU915, CN470, AS923, KR920, IN865, EU433}
+)
+
+// fixedPlan will build a US915-style channel plan, given the first 125kHz
+// and first 500kHz uplink frequency.
+func fixedPlan(name string, band rf.Range, narrow, wide rf.Hz) Plan {
+	uplink := join(
+		channels(Uplink, 0, narrow, rf.KHz*200, 64, bw125),
+		channels(Uplink, 64, wide, rf.KHz*1600, 8, bw500),
+	)
+	return Plan{
+		Name:            name,
+		Band:            band,
+		DefaultChannels: uplink,
+		Uplink:          uplink,
+		Downlink:        channels(Downlink, 0, rf.KHz*923300, rf.KHz*600, 8, bw500),
+		RX2:             Channel{Direction: Downlink, Frequency: rf.KHz * 923300, Bandwidth: bw500},
+		RX2DataRate:     8,
+		SubBandSize:     8,
+	}
+}
+
+// as923 will build an AS923 channel plan, offset from AS923-1 by the
+// provided amount.
+func as923(name string, offset rf.Hz) Plan {
+	return Plan{
+		Name:            name,
+		Band:            rf.Range{rf.MHz * 915, rf.MHz * 928},
+		DefaultChannels: uplinks(rf.KHz*923200+offset, rf.KHz*923400+offset),
+		Uplink:          uplinks(rf.KHz*923200+offset, rf.KHz*923400+offset),
+		RX2:             Channel{Direction: Downlink, Frequency: rf.KHz*923200 + offset, Bandwidth: bw125},
+		RX2DataRate:     2,
+	}
+}
+
+// AS923Group will return the AS923 channel plan for the provided group, 1
+// through 4 for AS923-1 through AS923-4, or ErrUnknownGroup for any other
+// group. Each group is offset from AS923-1 to fit within the local
+// regulations.
+func AS923Group(group int) (Plan, error) {
+	switch group {
+	case 1:
+		return AS923, nil
+	case 2:
+		return as923("AS923-2", -rf.KHz*1800), nil
+	case 3:
+		return as923("AS923-3", -rf.KHz*6600), nil
+	case 4:
+		return as923("AS923-4", -rf.KHz*5900), nil
+	}
+	return Plan{}, ErrUnknownGroup
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,289 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package marine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+var (
+	// ChannelBandwidth is the bandwidth of a Marine VHF channel.
+	ChannelBandwidth = rf.KHz * 25
+
+	// DuplexOffset is the offset between the Ship and Coast station
+	// transmit frequencies on a duplex channel.
+	DuplexOffset = rf.KHz * 4600
+
+	// Band is the VHF Maritime Mobile band, covering all the channels
+	// listed in Channels.
+	Band = rf.Allocation{
+		Name:  "Marine VHF",
+		Range: rf.Range{rf.KHz * 156012.5, rf.KHz * 162037.5},
+	}
+
+	// ErrUnknownChannel will be returned when a channel designator or
+	// frequency does not map to a known Marine VHF channel.
+	ErrUnknownChannel = fmt.Errorf("marine: unknown channel")
+)
+
+// Suffix is the letter appended to a channel number to indicate that only
+// one of the two frequencies of a duplex channel is in use, as a simplex
+// channel.
+type Suffix string
+
+const (
+	// SuffixNone is used for the channel as listed in Appendix 18, either
+	// simplex or duplex.
+	SuffixNone Suffix = ""
+
+	// SuffixA indicates simplex operation on the Ship transmit frequency
+	// of a duplex channel.
+	SuffixA Suffix = "A"
+
+	// SuffixB indicates simplex operation on the Coast transmit frequency
+	// of a duplex channel.
+	SuffixB Suffix = "B"
+)
+
+// Channel is a single Marine VHF channel.
+type Channel struct {
+	// Number is the channel number, such as 16.
+	Number int
+
+	// Suffix is set if only one half of a duplex channel is in use.
+	Suffix Suffix
+
+	// Ship is the frequency a Ship Station transmits on. This will be 0
+	// if Ship Stations do not transmit on this channel.
+	Ship rf.Hz
+
+	// Coast is the frequency a Coast Station transmits on. This will be 0
+	// if Coast Stations do not transmit on this channel.
+	Coast rf.Hz
+}
+
+// String will return the channel designator, such as "06", "16" or "87B".
+func (c Channel) String() string {
+	return fmt.Sprintf("%02d%s", c.Number, c.Suffix)
+}
+
+// Duplex will return true if the Ship and Coast stations transmit on
+// different frequencies.
+func (c Channel) Duplex() bool {
+	return c.Ship != 0 && c.Coast != 0 && c.Ship != c.Coast
+}
+
+// Frequencies will return all the distinct frequencies used by this channel.
+func (c Channel) Frequencies() []rf.Hz {
+	ret := []rf.Hz{}
+	if c.Ship != 0 {
+		ret = append(ret, c.Ship)
+	}
+	if c.Coast != 0 && c.Coast != c.Ship {
+		ret = append(ret, c.Coast)
+	}
+	return ret
+}
+
+// Allocations will return the Ship and Coast transmit frequencies of the
+// Channel as an rf.Allocations. Simplex channels will only have a single
+// Allocation.
+func (c Channel) Allocations() rf.Allocations {
+	half := ChannelBandwidth / 2
+	if !c.Duplex() {
+		return rf.Allocations{{
+			Name:  fmt.Sprintf("Marine %s", c),
+			Range: rf.Range{-half, half}.Add(c.Frequencies()[0]),
+		}}
+	}
+	return rf.Allocations{
+		{
+			Name:  fmt.Sprintf("Marine %s Ship", c),
+			Range: rf.Range{-half, half}.Add(c.Ship),
+		},
+		{
+			Name:  fmt.Sprintf("Marine %s Coast", c),
+			Range: rf.Range{-half, half}.Add(c.Coast),
+		},
+	}
+}
+
+// shipFrequency will return the Ship transmit frequency for the provided
+// channel number, or false if the number isn't a valid channel.
+func shipFrequency(number int) (rf.Hz, bool) {
+	base := rf.KHz * 156025
+	spacing := rf.KHz * 50
+	switch {
+	case number >= 1 && number <= 28:
+		return base + ChannelBandwidth + spacing*rf.Hz(number-1), true
+	case number >= 60 && number <= 88:
+		return base + spacing*rf.Hz(number-60), true
+	default:
+		return 0, false
+	}
+}
+
+// simplexChannels are the channels where both Ship and Coast stations
+// transmit on the Ship frequency.
+var simplexChannels = map[int]bool{
+	6: true, 8: true, 9: true, 10: true, 11: true, 12: true, 13: true,
+	14: true, 15: true, 16: true, 17: true, 67: true, 68: true, 69: true,
+	70: true, 71: true, 72: true, 73: true, 74: true, 75: true, 76: true,
+	77: true,
+}
+
+// splitChannels are duplex channels which are only ever used as two
+// simplex channels, with the A and B suffix.
+var splitChannels = map[int]bool{
+	87: true, 88: true,
+}
+
+// NewChannel will create a Channel given a channel number and suffix.
+// Suffix A and B are only valid on duplex channels.
+func NewChannel(number int, suffix Suffix) (Channel, error) {
+	ship, ok := shipFrequency(number)
+	if !ok {
+		return Channel{}, ErrUnknownChannel
+	}
+	c := Channel{Number: number, Suffix: suffix}
+
+	if simplexChannels[number] {
+		if suffix != SuffixNone {
+			return Channel{}, ErrUnknownChannel
+		}
+		c.Ship = ship
+		c.Coast = ship
+		return c, nil
+	}
+
+	switch suffix {
+	case SuffixNone:
+		c.Ship = ship
+		c.Coast = ship + DuplexOffset
+	case SuffixA:
+		c.Ship = ship
+		c.Coast = ship
+	case SuffixB:
+		c.Ship = ship + DuplexOffset
+		c.Coast = ship + DuplexOffset
+	default:
+		return Channel{}, ErrUnknownChannel
+	}
+	return c, nil
+}
+
+// ParseChannel will parse a channel designator such as "16", "06" or "87B"
+// into a Channel.
+func ParseChannel(designator string) (Channel, error) {
+	designator = strings.ToUpper(strings.TrimSpace(designator))
+	suffix := SuffixNone
+	switch {
+	case strings.HasSuffix(designator, string(SuffixA)):
+		suffix = SuffixA
+	case strings.HasSuffix(designator, string(SuffixB)):
+		suffix = SuffixB
+	}
+	number, err := strconv.Atoi(strings.TrimSuffix(designator, string(suffix)))
+	if err != nil {
+		return Channel{}, ErrUnknownChannel
+	}
+	return NewChannel(number, suffix)
+}
+
+// MustParseChannel will run the designator through ParseChannel, and
+// on error, panic.
+func MustParseChannel(designator string) Channel {
+	c, err := ParseChannel(designator)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Lookup will return the channel from Channels that contains the
+// provided frequency.
+func Lookup(freq rf.Hz) (Channel, error) {
+	half := ChannelBandwidth / 2
+	for _, c := range Channels {
+		for _, f := range c.Frequencies() {
+			if (rf.Range{-half, half}).Add(f).ContainsFrequency(freq) {
+				return c, nil
+			}
+		}
+	}
+	return Channel{}, ErrUnknownChannel
+}
+
+// Channels are all the International Maritime Mobile VHF channels, as
+// listed in Appendix 18 of the ITU Radio Regulations, in the order of
+// that table. Channels 87 and 88 are listed as their simplex A and B
+// halves, where 87B and 88B are AIS 1 and AIS 2.
+var Channels = func() []Channel {
+	ret := []Channel{}
+	for _, number := range []int{
+		60, 1, 61, 2, 62, 3, 63, 4, 64, 5, 65, 6, 66, 7, 67, 8, 68, 9, 69,
+		10, 70, 11, 71, 12, 72, 13, 73, 14, 74, 15, 75, 16, 76, 17, 77,
+		18, 78, 19, 79, 20, 80, 21, 81, 22, 82, 23, 83, 24, 84, 25, 85,
+		26, 86, 27, 87, 28, 88,
+	} {
+		suffixes := []Suffix{SuffixNone}
+		if splitChannels[number] {
+			suffixes = []Suffix{SuffixA, SuffixB}
+		}
+		for _, suffix := range suffixes {
+			c, err := NewChannel(number, suffix)
+			if err != nil {
+				panic(err)
+			}
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}()
+
+var (
+	// Channel16 is the international distress, safety and calling channel.
+	Channel16 = MustParseChannel("16")
+
+	// Channel70 is used exclusively for Digital Selective Calling.
+	Channel70 = MustParseChannel("70")
+
+	// AIS1 is the first Automatic Identification System channel, 87B.
+	AIS1 = MustParseChannel("87B")
+
+	// AIS2 is the second Automatic Identification System channel, 88B.
+	AIS2 = MustParseChannel("88B")
+)
+
+// Allocations returns every channel in Channels as an rf.Allocations.
+func Allocations() rf.Allocations {
+	ret := rf.Allocations{}
+	for _, c := range Channels {
+		ret = append(ret, c.Allocations()...)
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
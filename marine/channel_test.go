@@ -0,0 +1,85 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package marine_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/marine"
+)
+
+func TestParseChannel(t *testing.T) {
+	c, err := marine.ParseChannel("16")
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*156800, c.Ship)
+	assert.Equal(t, rf.KHz*156800, c.Coast)
+	assert.False(t, c.Duplex())
+	assert.Equal(t, "16", c.String())
+
+	c, err = marine.ParseChannel("01")
+	assert.NoError(t, err)
+	assert.True(t, c.Duplex())
+	assert.Equal(t, rf.KHz*156050, c.Ship)
+	assert.Equal(t, rf.KHz*160650, c.Coast)
+
+	c, err = marine.ParseChannel("22a")
+	assert.NoError(t, err)
+	assert.Equal(t, "22A", c.String())
+	assert.Equal(t, rf.KHz*157100, c.Ship)
+	assert.Equal(t, rf.KHz*157100, c.Coast)
+
+	_, err = marine.ParseChannel("16A")
+	assert.Error(t, err)
+	_, err = marine.ParseChannel("40")
+	assert.Error(t, err)
+}
+
+func TestAIS(t *testing.T) {
+	assert.Equal(t, rf.KHz*161975, marine.AIS1.Coast)
+	assert.Equal(t, rf.KHz*162025, marine.AIS2.Coast)
+	assert.Equal(t, "87B", marine.AIS1.String())
+}
+
+func TestLookup(t *testing.T) {
+	c, err := marine.Lookup(rf.KHz * 161980)
+	assert.NoError(t, err)
+	assert.Equal(t, "87B", c.String())
+
+	c, err = marine.Lookup(rf.KHz * 160650)
+	assert.NoError(t, err)
+	assert.Equal(t, "01", c.String())
+
+	_, err = marine.Lookup(rf.KHz * 146520)
+	assert.Error(t, err)
+}
+
+func TestAllocations(t *testing.T) {
+	allocations := marine.Allocations()
+	for _, allocation := range allocations {
+		assert.True(t, marine.Band.Range.ContainsRange(allocation.Range), allocation.Name)
+	}
+	assert.Equal(t, "Marine 16", allocations.ContainingFrequency(rf.KHz*156800).First().Name)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,281 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package memories
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"hz.tools/rf"
+	"hz.tools/rf/repeater"
+)
+
+// ErrInvalidCSV will be returned when a CHIRP CSV file is not well formed.
+var ErrInvalidCSV = fmt.Errorf("memories: invalid CHIRP CSV")
+
+// chirpColumns are the columns of a CHIRP CSV file, in the order CHIRP
+// writes them.
+var chirpColumns = []string{
+	"Location", "Name", "Frequency", "Duplex", "Offset", "Tone",
+	"rToneFreq", "cToneFreq", "DtcsCode", "DtcsPolarity", "RxDtcsCode",
+	"CrossMode", "Mode", "TStep", "Skip", "Power", "Comment",
+	"URCALL", "RPT1CALL", "RPT2CALL", "DVCODE",
+}
+
+// chirpExtra are the CHIRP columns kept in Memory.Extra.
+var chirpExtra = []string{"URCALL", "RPT1CALL", "RPT2CALL", "DVCODE"}
+
+// Defaults CHIRP writes for unused fields, which are used when writing a
+// zero value.
+var (
+	defaultTone = repeater.CTCSS(88.5)
+	defaultDCS  = repeater.DCS{Code: 0023}
+	defaultStep = rf.KHz * 5
+)
+
+// parseMHz will convert a decimal number of MHz to rf.Hz, rounded to the
+// nearest Hz.
+func parseMHz(value string) (rf.Hz, error) {
+	if value == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, ErrInvalidCSV
+	}
+	return rf.Hz(math.Round(f * float64(rf.MHz))), nil
+}
+
+// parseDCS will parse a CHIRP DCS code, such as "023", with the polarity
+// given by a single "N" or "R" character.
+func parseDCS(code string, polarity byte) (repeater.DCS, error) {
+	if code == "" {
+		return repeater.DCS{}, nil
+	}
+	c, err := strconv.ParseUint(code, 8, 16)
+	if err != nil {
+		return repeater.DCS{}, ErrInvalidCSV
+	}
+	return repeater.DCS{Code: uint16(c), Inverted: polarity == 'R'}, nil
+}
+
+// ReadCSV will read every Memory from a CHIRP CSV file. Columns are found by
+// name from the header row, so files from older versions of CHIRP, which
+// have fewer columns, can also be read. Rows without a Frequency are
+// skipped.
+func ReadCSV(r io.Reader) (Memories, error) {
+	c := csv.NewReader(r)
+	c.FieldsPerRecord = -1
+
+	header, err := c.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["Frequency"]; !ok {
+		return nil, ErrInvalidCSV
+	}
+
+	ret := Memories{}
+	for {
+		record, err := c.Read()
+		if err == io.EOF {
+			return ret, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		get := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		if get("Frequency") == "" {
+			continue
+		}
+		m, err := parseRow(get)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, m)
+	}
+}
+
+// parseRow will parse a single row of a CHIRP CSV file.
+func parseRow(get func(string) string) (Memory, error) {
+	var (
+		m   Memory
+		err error
+	)
+	m.Name = get("Name")
+	m.Duplex = Duplex(get("Duplex"))
+	m.ToneMode = ToneMode(get("Tone"))
+	m.CrossMode = get("CrossMode")
+	m.Mode = get("Mode")
+	m.Skip = get("Skip")
+	m.Power = get("Power")
+	m.Comment = get("Comment")
+
+	if location := get("Location"); location != "" {
+		if m.Location, err = strconv.Atoi(location); err != nil {
+			return Memory{}, ErrInvalidCSV
+		}
+	}
+	if m.RX, err = parseMHz(get("Frequency")); err != nil {
+		return Memory{}, err
+	}
+	if m.Offset, err = parseMHz(get("Offset")); err != nil {
+		return Memory{}, err
+	}
+	if step := get("TStep"); step != "" {
+		khz, err := strconv.ParseFloat(step, 64)
+		if err != nil {
+			return Memory{}, ErrInvalidCSV
+		}
+		m.Step = rf.Hz(math.Round(khz * float64(rf.KHz)))
+	}
+
+	for _, tone := range []struct {
+		column string
+		to     *repeater.CTCSS
+	}{
+		{"rToneFreq", &m.TXTone},
+		{"cToneFreq", &m.RXTone},
+	} {
+		if value := get(tone.column); value != "" {
+			if *tone.to, err = repeater.ParseCTCSS(value); err != nil {
+				return Memory{}, err
+			}
+		}
+	}
+
+	polarity := get("DtcsPolarity") + "NN"
+	if m.TXDCS, err = parseDCS(get("DtcsCode"), polarity[0]); err != nil {
+		return Memory{}, err
+	}
+	rxCode := get("RxDtcsCode")
+	if rxCode == "" {
+		rxCode = get("DtcsCode")
+	}
+	if m.RXDCS, err = parseDCS(rxCode, polarity[1]); err != nil {
+		return Memory{}, err
+	}
+
+	for _, name := range chirpExtra {
+		if value := get(name); value != "" {
+			if m.Extra == nil {
+				m.Extra = map[string]string{}
+			}
+			m.Extra[name] = value
+		}
+	}
+	return m, nil
+}
+
+// formatMHz will format the frequency as a decimal number of MHz, as
+// CHIRP does.
+func formatMHz(freq rf.Hz) string {
+	return strconv.FormatFloat(float64(freq/rf.MHz), 'f', 6, 64)
+}
+
+// WriteCSV will write the memories as a CHIRP CSV file. Unset tones, DCS
+// codes and steps are written as CHIRP's defaults, since CHIRP requires a
+// value in those columns.
+func WriteCSV(w io.Writer, memories Memories) error {
+	c := csv.NewWriter(w)
+	if err := c.Write(chirpColumns); err != nil {
+		return err
+	}
+
+	for _, m := range memories {
+		txTone, rxTone := m.TXTone, m.RXTone
+		if txTone == 0 {
+			txTone = defaultTone
+		}
+		if rxTone == 0 {
+			rxTone = defaultTone
+		}
+		txDCS, rxDCS := m.TXDCS, m.RXDCS
+		if txDCS.Code == 0 {
+			txDCS.Code = defaultDCS.Code
+		}
+		if rxDCS.Code == 0 {
+			rxDCS.Code = defaultDCS.Code
+		}
+		polarity := func(d repeater.DCS) string {
+			if d.Inverted {
+				return "R"
+			}
+			return "N"
+		}
+		step := m.Step
+		if step == 0 {
+			step = defaultStep
+		}
+		crossMode := m.CrossMode
+		if crossMode == "" {
+			crossMode = "Tone->Tone"
+		}
+		mode := m.Mode
+		if mode == "" {
+			mode = "FM"
+		}
+
+		record := []string{
+			strconv.Itoa(m.Location),
+			m.Name,
+			formatMHz(m.RX),
+			string(m.Duplex),
+			formatMHz(m.Offset),
+			string(m.ToneMode),
+			txTone.String(),
+			rxTone.String(),
+			fmt.Sprintf("%03o", txDCS.Code),
+			polarity(txDCS) + polarity(rxDCS),
+			fmt.Sprintf("%03o", rxDCS.Code),
+			crossMode,
+			mode,
+			strconv.FormatFloat(float64(step/rf.KHz), 'f', 2, 64),
+			m.Skip,
+			m.Power,
+			m.Comment,
+		}
+		for _, name := range chirpExtra {
+			record = append(record, m.Extra[name])
+		}
+		if err := c.Write(record); err != nil {
+			return err
+		}
+	}
+	c.Flush()
+	return c.Error()
+}
+
+// vim: foldmethod=marker
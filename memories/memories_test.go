@@ -0,0 +1,97 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package memories_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/memories"
+	"hz.tools/rf/repeater"
+)
+
+const chirp = `Location,Name,Frequency,Duplex,Offset,Tone,rToneFreq,cToneFreq,DtcsCode,DtcsPolarity,RxDtcsCode,CrossMode,Mode,TStep,Skip,Power,Comment,URCALL,RPT1CALL,RPT2CALL,DVCODE
+0,CALL,146.520000,,0.000000,,88.5,88.5,023,NN,023,Tone->Tone,FM,5.00,,High,National simplex,,,,
+1,W1AW,146.940000,-,0.600000,Tone,100.0,88.5,023,NN,023,Tone->Tone,FM,5.00,,High,,,,,
+2,GMRS,462.550000,+,5.000000,DTCS,88.5,88.5,754,RN,023,Tone->Tone,NFM,12.50,S,Low,,,,,
+3,WX1,162.550000,off,0.000000,,88.5,88.5,023,NN,023,Tone->Tone,FM,25.00,,,,,,,
+`
+
+func TestReadCSV(t *testing.T) {
+	m, err := memories.ReadCSV(strings.NewReader(chirp))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(m))
+
+	assert.Equal(t, "CALL", m[0].Name)
+	assert.Equal(t, rf.KHz*146520, m[0].RX)
+	assert.Equal(t, rf.KHz*146520, m[0].TX())
+	assert.Equal(t, "National simplex", m[0].Comment)
+
+	assert.Equal(t, memories.Minus, m[1].Duplex)
+	assert.Equal(t, rf.KHz*146340, m[1].TX())
+	assert.Equal(t, memories.Tone, m[1].ToneMode)
+	assert.Equal(t, repeater.CTCSS(100.0), m[1].TXTone)
+	assert.Equal(t, "146.940- 100.0", m[1].Repeater().String())
+
+	assert.Equal(t, rf.KHz*467550, m[2].TX())
+	assert.Equal(t, repeater.DCS{Code: 0754, Inverted: true}, m[2].TXDCS)
+	assert.Equal(t, rf.KHz*12.5, m[2].Step)
+	assert.Equal(t, "S", m[2].Skip)
+
+	assert.Equal(t, rf.Hz(0), m[3].TX())
+}
+
+func TestWriteCSV(t *testing.T) {
+	m, err := memories.ReadCSV(strings.NewReader(chirp))
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, memories.WriteCSV(&buf, m))
+	assert.Equal(t, chirp, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, memories.WriteCSV(&buf, memories.Memories{{Location: 5, RX: rf.KHz * 446006.25}}))
+	assert.Contains(t, buf.String(), "5,,446.006250,,0.000000,,88.5,88.5,023,NN,023,Tone->Tone,FM,5.00,,,,,,,\n")
+}
+
+func TestValidate(t *testing.T) {
+	m, err := memories.ReadCSV(strings.NewReader(chirp))
+	assert.NoError(t, err)
+
+	twoMeters := rf.Allocations{{Name: "2m", Range: rf.Range{rf.MHz * 144, rf.MHz * 148}}}
+	errs := m.Validate(twoMeters)
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, "location 2: memories: receive frequency out of band", errs[0].Error())
+	assert.Equal(t, memories.ErrRXOutOfBand, errs[1].(memories.ValidationError).Err)
+
+	assert.NoError(t, m[3].Validate(nil))
+
+	bad := memories.Memory{RX: rf.KHz * 146520, Duplex: memories.Plus, Offset: rf.MHz * 5}
+	assert.Equal(t, memories.ErrTXOutOfBand, bad.Validate(twoMeters))
+	bad = memories.Memory{RX: rf.KHz * 146520, TXTone: 101}
+	assert.Equal(t, memories.ErrInvalidTone, bad.Validate(nil))
+}
+
+// vim: foldmethod=marker
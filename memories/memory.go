@@ -0,0 +1,232 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package memories
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+	"hz.tools/rf/repeater"
+)
+
+var (
+	// ErrRXOutOfBand will be returned when a Memory receives outside of
+	// every Allocation.
+	ErrRXOutOfBand = fmt.Errorf("memories: receive frequency out of band")
+
+	// ErrTXOutOfBand will be returned when a Memory transmits outside of
+	// every Allocation.
+	ErrTXOutOfBand = fmt.Errorf("memories: transmit frequency out of band")
+
+	// ErrInvalidTone will be returned when a Memory has a non-standard
+	// CTCSS tone or DCS code.
+	ErrInvalidTone = fmt.Errorf("memories: invalid tone")
+)
+
+// Duplex is how the transmit frequency of a Memory is found.
+type Duplex string
+
+const (
+	// Simplex transmits on the receive frequency.
+	Simplex Duplex = ""
+
+	// Plus transmits Offset above the receive frequency.
+	Plus Duplex = "+"
+
+	// Minus transmits Offset below the receive frequency.
+	Minus Duplex = "-"
+
+	// Split transmits on the frequency in Offset.
+	Split Duplex = "split"
+
+	// Off doesn't allow transmitting at all.
+	Off Duplex = "off"
+)
+
+// ToneMode is how CTCSS tones and DCS codes are used by a Memory, using
+// CHIRP's names.
+type ToneMode string
+
+const (
+	// NoTone doesn't send or require any tone.
+	NoTone ToneMode = ""
+
+	// Tone sends the TXTone, but doesn't require a tone to receive.
+	Tone ToneMode = "Tone"
+
+	// TSQL sends and requires the RXTone.
+	TSQL ToneMode = "TSQL"
+
+	// DTCS sends and requires the TXDCS code.
+	DTCS ToneMode = "DTCS"
+
+	// Cross uses different tone types to send and receive, as given by
+	// the CrossMode, such as "Tone->DTCS".
+	Cross ToneMode = "Cross"
+)
+
+// Memory is a single channel memory of a radio.
+type Memory struct {
+	// Location is the memory number.
+	Location int
+
+	// Name of the memory, as shown on the radio's display.
+	Name string
+
+	// RX is the receive frequency.
+	RX rf.Hz
+
+	// Duplex and Offset determine the transmit frequency, see TX.
+	Duplex Duplex
+	Offset rf.Hz
+
+	// ToneMode sets which of the tones and codes are used.
+	ToneMode ToneMode
+
+	// TXTone is the CTCSS tone sent in the Tone mode.
+	TXTone repeater.CTCSS
+
+	// RXTone is the CTCSS tone sent and required in the TSQL mode.
+	RXTone repeater.CTCSS
+
+	// TXDCS is the DCS code sent in the DTCS mode.
+	TXDCS repeater.DCS
+
+	// RXDCS is the DCS code required when receiving in the Cross mode.
+	RXDCS repeater.DCS
+
+	// CrossMode is the tone types used in the Cross mode, such as
+	// "Tone->Tone" or "DTCS->".
+	CrossMode string
+
+	// Mode is the modulation, such as "FM", "NFM", "AM" or "USB".
+	Mode string
+
+	// Step is the tuning step.
+	Step rf.Hz
+
+	// Skip is "S" to skip the memory when scanning, or "P" to give it
+	// priority.
+	Skip string
+
+	// Power is the transmit power level, as named by the radio, such as
+	// "High" or "5.0W".
+	Power string
+
+	// Comment is a free-form comment.
+	Comment string
+
+	// Extra are any other CHIRP columns, such as the D-STAR URCALL.
+	Extra map[string]string
+}
+
+// TX will return the transmit frequency, or zero if transmitting is Off.
+func (m Memory) TX() rf.Hz {
+	switch m.Duplex {
+	case Plus:
+		return m.RX + m.Offset
+	case Minus:
+		return m.RX - m.Offset
+	case Split:
+		return m.Offset
+	case Off:
+		return 0
+	}
+	return m.RX
+}
+
+// Repeater will return the Memory as a repeater.Repeater, with the tone or
+// code it transmits.
+func (m Memory) Repeater() repeater.Repeater {
+	r := repeater.Repeater{Output: m.RX, Input: m.TX()}
+	switch m.ToneMode {
+	case Tone, Cross:
+		r.CTCSS = m.TXTone
+	case TSQL:
+		r.CTCSS = m.RXTone
+	case DTCS:
+		r.DCS = m.TXDCS
+	}
+	return r
+}
+
+// Validate will check that the tones and codes of the Memory are standard,
+// and, if any allocations are given, that the receive and transmit
+// frequencies are each within one of them, such as the bands a radio can
+// tune or an operator may use.
+func (m Memory) Validate(allocations rf.Allocations) error {
+	for _, tone := range []repeater.CTCSS{m.TXTone, m.RXTone} {
+		if tone != 0 && !tone.Valid() {
+			return ErrInvalidTone
+		}
+	}
+	for _, code := range []repeater.DCS{m.TXDCS, m.RXDCS} {
+		if code != (repeater.DCS{}) && !code.Valid() {
+			return ErrInvalidTone
+		}
+	}
+	if len(allocations) == 0 {
+		return nil
+	}
+	if len(allocations.ContainingFrequency(m.RX)) == 0 {
+		return ErrRXOutOfBand
+	}
+	if tx := m.TX(); tx != 0 && len(allocations.ContainingFrequency(tx)) == 0 {
+		return ErrTXOutOfBand
+	}
+	return nil
+}
+
+// ValidationError is a Memory that failed validation.
+type ValidationError struct {
+	// Location of the Memory.
+	Location int
+
+	// Err is why it failed validation.
+	Err error
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("location %d: %s", e.Location, e.Err)
+}
+
+// Unwrap will return the underlying error.
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Memories is a list of Memory, such as every channel of a radio.
+type Memories []Memory
+
+// Validate will validate every Memory, returning a ValidationError for each
+// that fails.
+func (m Memories) Validate(allocations rf.Allocations) []error {
+	ret := []error{}
+	for _, memory := range m {
+		if err := memory.Validate(allocations); err != nil {
+			ret = append(ret, ValidationError{Location: memory.Location, Err: err})
+		}
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
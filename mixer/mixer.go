@@ -0,0 +1,238 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package mixer
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"hz.tools/rf"
+)
+
+// Injection is which side of the RF the LO is on.
+type Injection int
+
+const (
+	// LowSide injection has the LO below the RF.
+	LowSide Injection = iota
+
+	// HighSide injection has the LO above the RF.
+	HighSide
+)
+
+// String will return "low-side" or "high-side".
+func (i Injection) String() string {
+	if i == HighSide {
+		return "high-side"
+	}
+	return "low-side"
+}
+
+// Plan is a single mixing stage, converting RF to IF with an LO.
+type Plan struct {
+	// RF is the range of input frequencies.
+	RF rf.Range
+
+	// LO is the range the local oscillator is tuned over. A fixed LO is a
+	// Range with the same start and end, such as rf.Range{lo, lo}.
+	LO rf.Range
+
+	// IF is the passband of the IF filter after the mixer.
+	IF rf.Range
+}
+
+// Fixed will return a Plan for a fixed LO frequency.
+func Fixed(input rf.Range, lo rf.Hz, output rf.Range) Plan {
+	return Plan{RF: input, LO: rf.Range{lo, lo}, IF: output}
+}
+
+// Injection will return HighSide if the LO is above the RF, or LowSide
+// otherwise.
+func (p Plan) Injection() Injection {
+	if p.LO.Center() > p.RF.Center() {
+		return HighSide
+	}
+	return LowSide
+}
+
+// Sum will return true if the IF is the sum of the LO and RF (an
+// up-conversion), rather than the difference.
+func (p Plan) Sum() bool {
+	ifc := p.IF.Center()
+	sum := p.LO.Center() + p.RF.Center()
+	diff := rf.Hz(math.Abs(float64(p.LO.Center() - p.RF.Center())))
+	return math.Abs(float64(sum-ifc)) < math.Abs(float64(diff-ifc))
+}
+
+// desired will return the (m, n) of the intended mixing product.
+func (p Plan) desired() (int, int) {
+	if p.Sum() {
+		return 1, 1
+	}
+	return 1, -1
+}
+
+// Inverted will return true if the spectrum is inverted by the mixer,
+// which is the case for the difference product with HighSide injection.
+func (p Plan) Inverted() bool {
+	m, n := p.desired()
+	_, inverted := product(p.LO, p.RF, m, n)
+	return inverted
+}
+
+// Desired will return the range of the intended mixing product.
+func (p Plan) Desired() rf.Range {
+	m, n := p.desired()
+	r, _ := product(p.LO, p.RF, m, n)
+	return r
+}
+
+// Image will return the band of input frequencies that will also be
+// converted to the IF by the intended mixing product, on the other side of
+// the LO.
+func (p Plan) Image() rf.Range {
+	if p.Sum() {
+		// RF + LO is also produced by RF' - LO, where RF' = RF + 2LO.
+		return rf.Range{p.RF[0] + 2*p.LO[0], p.RF[1] + 2*p.LO[1]}
+	}
+	return rf.Range{2*p.LO[0] - p.RF[1], 2*p.LO[1] - p.RF[0]}
+}
+
+// Spur is an m×LO + n×RF mixing product.
+type Spur struct {
+	// M is the LO harmonic.
+	M int
+
+	// N is the RF harmonic, which is negative for the difference.
+	N int
+
+	// Range of frequencies the product covers as the RF and LO vary.
+	Range rf.Range
+
+	// Inverted is true if the product moves down as the RF moves up.
+	Inverted bool
+}
+
+// Order will return the order of the Spur, |m| + |n|.
+func (s Spur) Order() int {
+	n := s.N
+	if n < 0 {
+		n = -n
+	}
+	return s.M + n
+}
+
+// String will return the Spur in the usual notation, such as "2LO-3RF".
+func (s Spur) String() string {
+	term := func(c int, name string) string {
+		switch c {
+		case 1:
+			return name
+		case -1:
+			return "-" + name
+		}
+		return fmt.Sprintf("%d%s", c, name)
+	}
+	switch {
+	case s.M == 0:
+		return term(s.N, "RF")
+	case s.N == 0:
+		return term(s.M, "LO")
+	case s.N > 0:
+		return term(s.M, "LO") + "+" + term(s.N, "RF")
+	}
+	return term(s.M, "LO") + term(s.N, "RF")
+}
+
+// Allocation will return the Spur as an rf.Allocation, named by its
+// (m,n), with the Spur set as the Metadata.
+func (s Spur) Allocation() rf.Allocation {
+	return rf.Allocation{
+		Name:     fmt.Sprintf("(%d,%d)", s.M, s.N),
+		Range:    s.Range,
+		Metadata: s,
+	}
+}
+
+// SpurOf will return the Spur of an rf.Allocation returned by Spur.Allocation, and false if the
+// rf.Allocation doesn't have one.
+func SpurOf(a rf.Allocation) (Spur, bool) {
+	s, ok := a.Metadata.(Spur)
+	return s, ok
+}
+
+// product will return the range of |m×LO + n×RF| as the LO and RF vary
+// over their ranges, and whether it is inverted relative to the RF.
+func product(lo, input rf.Range, m, n int) (rf.Range, bool) {
+	low := rf.Hz(m)*lo[0] + rf.Hz(n)*input[0]
+	high := rf.Hz(m)*lo[1] + rf.Hz(n)*input[1]
+	if n < 0 {
+		low = rf.Hz(m)*lo[0] + rf.Hz(n)*input[1]
+		high = rf.Hz(m)*lo[1] + rf.Hz(n)*input[0]
+	}
+
+	negative := low+high < 0
+	switch {
+	case high <= 0:
+		low, high = -high, -low
+	case low < 0:
+		if -low > high {
+			high = -low
+		}
+		low = 0
+	}
+	return rf.Range{low, high}, (n < 0) != negative && n != 0
+}
+
+// Spurs will return every m×LO ± n×RF product, up to the provided order,
+// other than the intended product, whose Range overlaps the IF. The
+// Allocations are sorted by order, then frequency.
+func (p Plan) Spurs(order int) rf.Allocations {
+	dm, dn := p.desired()
+	spurs := []Spur{}
+	for m := 0; m <= order; m++ {
+		for n := -(order - m); n <= order-m; n++ {
+			if (m == 0 && n <= 0) || (m == dm && n == dn) {
+				continue
+			}
+			r, inverted := product(p.LO, p.RF, m, n)
+			if !r.Overlaps(p.IF) {
+				continue
+			}
+			spurs = append(spurs, Spur{M: m, N: n, Range: r, Inverted: inverted})
+		}
+	}
+	sort.SliceStable(spurs, func(i, j int) bool {
+		if spurs[i].Order() != spurs[j].Order() {
+			return spurs[i].Order() < spurs[j].Order()
+		}
+		return spurs[i].Range[0] < spurs[j].Range[0]
+	})
+
+	ret := rf.Allocations{}
+	for _, spur := range spurs {
+		ret = append(ret, spur.Allocation())
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,92 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package mixer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/mixer"
+)
+
+func TestFMBroadcast(t *testing.T) {
+	// Classic FM broadcast receiver, tuned to 100MHz with a 10.7MHz IF.
+	plan := mixer.Fixed(
+		rf.Range{rf.KHz * 99900, rf.KHz * 100100},
+		rf.KHz*110700,
+		rf.Range{rf.KHz * 10600, rf.KHz * 10800},
+	)
+	assert.Equal(t, mixer.HighSide, plan.Injection())
+	assert.True(t, plan.Inverted())
+	assert.False(t, plan.Sum())
+	assert.Equal(t, rf.Range{rf.KHz * 10600, rf.KHz * 10800}, plan.Desired())
+	assert.Equal(t, rf.Range{rf.KHz * 121300, rf.KHz * 121500}, plan.Image())
+
+	plan.LO = rf.Range{rf.KHz * 89300, rf.KHz * 89300}
+	assert.Equal(t, mixer.LowSide, plan.Injection())
+	assert.False(t, plan.Inverted())
+	assert.Equal(t, rf.Range{rf.KHz * 78500, rf.KHz * 78700}, plan.Image())
+}
+
+func TestSpurs(t *testing.T) {
+	// A 2m downconverter to 28MHz with a 116MHz LO. The 7th order
+	// 4LO-3RF product lands in the IF.
+	plan := mixer.Fixed(
+		rf.Range{rf.MHz * 144, rf.MHz * 146},
+		rf.MHz*116,
+		rf.Range{rf.MHz * 28, rf.MHz * 30},
+	)
+	assert.Equal(t, 0, len(plan.Spurs(5)))
+
+	spurs := plan.Spurs(7)
+	names := []string{}
+	for _, spur := range spurs {
+		names = append(names, spur.Name)
+	}
+	assert.Contains(t, names, "(4,-3)")
+	assert.NotContains(t, names, "(1,-1)")
+
+	for _, spur := range spurs {
+		assert.True(t, spur.Range.Overlaps(plan.IF))
+	}
+
+	spur, ok := mixer.SpurOf(spurs[0])
+	assert.True(t, ok)
+	assert.Equal(t, "4LO-3RF", spur.String())
+	assert.Equal(t, rf.Range{rf.MHz * 26, rf.MHz * 32}, spur.Range)
+	assert.True(t, spur.Inverted)
+}
+
+func TestUpconversion(t *testing.T) {
+	plan := mixer.Fixed(
+		rf.Range{rf.MHz * 10, rf.MHz * 20},
+		rf.MHz*400,
+		rf.Range{rf.MHz * 400, rf.MHz * 430},
+	)
+	assert.True(t, plan.Sum())
+	assert.False(t, plan.Inverted())
+	assert.Equal(t, rf.Range{rf.MHz * 410, rf.MHz * 420}, plan.Desired())
+	assert.Equal(t, rf.Range{rf.MHz * 810, rf.MHz * 820}, plan.Image())
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,190 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package modulation
+
+import (
+	"hz.tools/rf"
+)
+
+// Scheme is a modulation scheme, along with the parameters required to
+// estimate the bandwidth it occupies.
+type Scheme interface {
+	// Bandwidth will return the necessary bandwidth of the signal.
+	Bandwidth() rf.Hz
+
+	// Range will return the range of frequencies occupied by the signal
+	// when transmitted on the provided carrier frequency.
+	Range(carrier rf.Hz) rf.Range
+}
+
+// centered will return a range of the provided bandwidth centered on the
+// carrier.
+func centered(carrier, bandwidth rf.Hz) rf.Range {
+	half := bandwidth / 2
+	return rf.Range{-half, half}.Add(carrier)
+}
+
+// Fits will return true if the signal, transmitted on the provided carrier
+// frequency, is entirely contained within the Allocation.
+func Fits(s Scheme, carrier rf.Hz, allocation rf.Allocation) bool {
+	return allocation.Range.ContainsRange(s.Range(carrier))
+}
+
+// FM is an analog frequency modulated signal.
+type FM struct {
+	// Deviation is the peak frequency deviation.
+	Deviation rf.Hz
+
+	// MaxAudio is the highest modulating frequency.
+	MaxAudio rf.Hz
+}
+
+// Bandwidth implements the Scheme interface, using Carson's rule.
+func (f FM) Bandwidth() rf.Hz {
+	return 2 * (f.Deviation + f.MaxAudio)
+}
+
+// Range implements the Scheme interface.
+func (f FM) Range(carrier rf.Hz) rf.Range {
+	return centered(carrier, f.Bandwidth())
+}
+
+// AM is a double-sideband amplitude modulated signal.
+type AM struct {
+	// MaxAudio is the highest modulating frequency.
+	MaxAudio rf.Hz
+}
+
+// Bandwidth implements the Scheme interface.
+func (a AM) Bandwidth() rf.Hz {
+	return 2 * a.MaxAudio
+}
+
+// Range implements the Scheme interface.
+func (a AM) Range(carrier rf.Hz) rf.Range {
+	return centered(carrier, a.Bandwidth())
+}
+
+// Sideband is the sideband used by a single-sideband signal.
+type Sideband int
+
+const (
+	// UpperSideband places the signal above the carrier.
+	UpperSideband Sideband = iota
+
+	// LowerSideband places the signal below the carrier.
+	LowerSideband
+)
+
+// SSB is a single-sideband suppressed carrier signal. Unlike the other
+// schemes, the signal is not centered on the carrier, but sits entirely
+// above or below it.
+type SSB struct {
+	// Sideband is either UpperSideband or LowerSideband.
+	Sideband Sideband
+
+	// MinAudio is the lowest modulating frequency, such as 300Hz for voice.
+	MinAudio rf.Hz
+
+	// MaxAudio is the highest modulating frequency, such as 3kHz for voice.
+	MaxAudio rf.Hz
+}
+
+// Bandwidth implements the Scheme interface.
+func (s SSB) Bandwidth() rf.Hz {
+	return s.MaxAudio - s.MinAudio
+}
+
+// Range implements the Scheme interface.
+func (s SSB) Range(carrier rf.Hz) rf.Range {
+	if s.Sideband == LowerSideband {
+		return rf.Range{carrier - s.MaxAudio, carrier - s.MinAudio}
+	}
+	return rf.Range{carrier + s.MinAudio, carrier + s.MaxAudio}
+}
+
+// FSK is a two-tone frequency shift keyed signal.
+type FSK struct {
+	// Shift is the difference between the mark and space frequencies.
+	Shift rf.Hz
+
+	// Baud is the symbol rate, in symbols per second.
+	Baud float64
+}
+
+// Bandwidth implements the Scheme interface, using the ITU-R SM.1138
+// formula for telegraphy, Bn = 2M + 2DK, where M is half the baud rate, D is
+// half the shift, and K is 1.2.
+func (f FSK) Bandwidth() rf.Hz {
+	return rf.Hz(f.Baud) + 1.2*f.Shift
+}
+
+// Range implements the Scheme interface. The carrier is the center
+// frequency, halfway between the mark and space tones.
+func (f FSK) Range(carrier rf.Hz) rf.Range {
+	return centered(carrier, f.Bandwidth())
+}
+
+// PSK is a linearly modulated signal, such as BPSK, QPSK or QAM, with a
+// raised-cosine pulse shape.
+type PSK struct {
+	// SymbolRate is the number of symbols per second.
+	SymbolRate rf.Hz
+
+	// RollOff is the raised-cosine roll-off factor (alpha), from 0 to 1.
+	RollOff float64
+}
+
+// QAM has the same occupied bandwidth as PSK at the same symbol rate and
+// roll-off.
+type QAM = PSK
+
+// Bandwidth implements the Scheme interface.
+func (p PSK) Bandwidth() rf.Hz {
+	return p.SymbolRate * rf.Hz(1+p.RollOff)
+}
+
+// Range implements the Scheme interface.
+func (p PSK) Range(carrier rf.Hz) rf.Range {
+	return centered(carrier, p.Bandwidth())
+}
+
+// OFDM is an orthogonal frequency division multiplexed signal.
+type OFDM struct {
+	// Subcarriers is the number of occupied subcarriers, including any
+	// pilots.
+	Subcarriers int
+
+	// Spacing is the subcarrier spacing.
+	Spacing rf.Hz
+}
+
+// Bandwidth implements the Scheme interface.
+func (o OFDM) Bandwidth() rf.Hz {
+	return rf.Hz(o.Subcarriers) * o.Spacing
+}
+
+// Range implements the Scheme interface.
+func (o OFDM) Range(carrier rf.Hz) rf.Range {
+	return centered(carrier, o.Bandwidth())
+}
+
+// vim: foldmethod=marker
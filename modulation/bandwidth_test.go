@@ -0,0 +1,65 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package modulation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/emission"
+	"hz.tools/rf/modulation"
+)
+
+func TestFM(t *testing.T) {
+	fm := modulation.FM{Deviation: rf.KHz * 5, MaxAudio: rf.KHz * 3}
+	assert.Equal(t, rf.KHz*16, fm.Bandwidth())
+	assert.Equal(t, rf.Range{rf.KHz * 146512, rf.KHz * 146528}, fm.Range(rf.KHz*146520))
+	assert.Equal(t, emission.MustParse("16K0F3E").Bandwidth, fm.Bandwidth())
+}
+
+func TestSSB(t *testing.T) {
+	usb := modulation.SSB{Sideband: modulation.UpperSideband, MinAudio: 300, MaxAudio: 3000}
+	assert.Equal(t, rf.Hz(2700), usb.Bandwidth())
+	assert.Equal(t, rf.Range{rf.KHz * 14200.3, rf.KHz * 14203}, usb.Range(rf.KHz*14200))
+
+	lsb := modulation.SSB{Sideband: modulation.LowerSideband, MinAudio: 300, MaxAudio: 3000}
+	assert.Equal(t, rf.Range{rf.KHz * 7197, rf.KHz * 7199.7}, lsb.Range(rf.KHz*7200))
+}
+
+func TestOtherSchemes(t *testing.T) {
+	assert.Equal(t, rf.KHz*6, modulation.AM{MaxAudio: rf.KHz * 3}.Bandwidth())
+	assert.InDelta(t, 249.45, float64(modulation.FSK{Shift: 170, Baud: 45.45}.Bandwidth()), 0.001)
+	assert.Equal(t, rf.KHz*1350, modulation.PSK{SymbolRate: rf.MHz, RollOff: 0.35}.Bandwidth())
+	assert.Equal(t, rf.KHz*1350, modulation.QAM{SymbolRate: rf.MHz, RollOff: 0.35}.Bandwidth())
+	assert.Equal(t, rf.KHz*16875, modulation.OFDM{Subcarriers: 54, Spacing: rf.KHz * 312.5}.Bandwidth())
+}
+
+func TestFits(t *testing.T) {
+	channel := rf.Allocation{Name: "FRS 1", Range: rf.Range{rf.KHz * 462556.25, rf.KHz * 462568.75}}
+	narrow := modulation.FM{Deviation: rf.KHz * 2.5, MaxAudio: rf.KHz * 3}
+	wide := modulation.FM{Deviation: rf.KHz * 5, MaxAudio: rf.KHz * 3}
+	assert.True(t, modulation.Fits(narrow, rf.KHz*462562.5, channel))
+	assert.False(t, modulation.Fits(wide, rf.KHz*462562.5, channel))
+}
+
+// vim: foldmethod=marker
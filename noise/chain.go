@@ -0,0 +1,124 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package noise
+
+import (
+	"hz.tools/rf"
+)
+
+// Stage is a single stage of a receive chain, such as a filter, amplifier
+// or mixer. Passive stages have a negative Gain and a NoiseFigure equal to
+// their loss.
+type Stage struct {
+	Name        string
+	Gain        rf.DB
+	NoiseFigure rf.DB
+}
+
+// Chain is a series of Stages, starting at the antenna.
+type Chain []Stage
+
+// Contribution is how a single Stage contributes to the noise of a Chain.
+type Contribution struct {
+	Stage Stage
+
+	// Excess is the noise factor this Stage adds to the Chain, which is
+	// its own excess noise factor divided by the gain before it.
+	Excess float64
+
+	// Share is the fraction of the Chain's excess noise that comes from
+	// this Stage.
+	Share float64
+
+	// Gain is the total gain of the Chain, up to and including this Stage.
+	Gain rf.DB
+
+	// NoiseFigure is the noise figure of the Chain, up to and including
+	// this Stage.
+	NoiseFigure rf.DB
+}
+
+// Cascade is the result of cascading a Chain.
+type Cascade struct {
+	// Stages are the Contribution of each Stage, in order.
+	Stages []Contribution
+
+	// Gain is the total gain of the Chain.
+	Gain rf.DB
+
+	// NoiseFigure is the noise figure of the Chain.
+	NoiseFigure rf.DB
+}
+
+// Cascade will compute the gain and noise figure of the Chain, using the
+// Friis formula for noise.
+func (c Chain) Cascade() Cascade {
+	var (
+		ret    = Cascade{Stages: make([]Contribution, len(c))}
+		gain   = 1.0
+		factor = 1.0
+	)
+	for i, stage := range c {
+		excess := (stage.NoiseFigure.Ratio() - 1) / gain
+		factor += excess
+		gain *= stage.Gain.Ratio()
+		ret.Stages[i] = Contribution{
+			Stage:       stage,
+			Excess:      excess,
+			Gain:        rf.DBFromRatio(gain),
+			NoiseFigure: rf.DBFromRatio(factor),
+		}
+	}
+	if factor > 1 {
+		for i := range ret.Stages {
+			ret.Stages[i].Share = ret.Stages[i].Excess / (factor - 1)
+		}
+	}
+	ret.Gain = rf.DBFromRatio(gain)
+	ret.NoiseFigure = rf.DBFromRatio(factor)
+	return ret
+}
+
+// Temperature will return the equivalent noise temperature of the Chain,
+// in kelvin.
+func (c Cascade) Temperature() float64 {
+	return Temperature(c.NoiseFigure)
+}
+
+// NoiseFloor will return the noise power at the output of the Chain, over
+// the bandwidth.
+func (c Cascade) NoiseFloor(bandwidth rf.Hz) rf.DBm {
+	return MDS(c.NoiseFigure, bandwidth).Add(c.Gain)
+}
+
+// MDS will return the minimum discernible signal at the input of the
+// Chain, over the bandwidth.
+func (c Cascade) MDS(bandwidth rf.Hz) rf.DBm {
+	return MDS(c.NoiseFigure, bandwidth)
+}
+
+// Sensitivity will return the weakest signal at the input of the Chain
+// that can be received with the required signal to noise ratio.
+func (c Cascade) Sensitivity(bandwidth rf.Hz, snr rf.DB) rf.DBm {
+	return Sensitivity(c.NoiseFigure, bandwidth, snr)
+}
+
+// vim: foldmethod=marker
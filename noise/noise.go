@@ -0,0 +1,81 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package noise
+
+import (
+	"math"
+
+	"hz.tools/rf"
+)
+
+const (
+	// Boltzmann is the Boltzmann constant, in joules per kelvin.
+	Boltzmann float64 = 1.380649e-23
+
+	// ReferenceTemperature is the standard noise temperature (T₀), in
+	// kelvin, that noise figures are defined against.
+	ReferenceTemperature float64 = 290
+)
+
+// ThermalAt will return the thermal noise power (kTB) over the bandwidth
+// at the provided temperature, in kelvin.
+func ThermalAt(bandwidth rf.Hz, kelvin float64) rf.DBm {
+	return rf.Watts(Boltzmann * kelvin * float64(bandwidth)).DBm()
+}
+
+// Thermal will return the thermal noise power (kTB) over the bandwidth at
+// the ReferenceTemperature, which is about -174dBm in 1Hz.
+func Thermal(bandwidth rf.Hz) rf.DBm {
+	return ThermalAt(bandwidth, ReferenceTemperature)
+}
+
+// ThermalRange will return the thermal noise power (kTB) across the Range
+// at the ReferenceTemperature.
+func ThermalRange(r rf.Range) rf.DBm {
+	return Thermal(rf.Hz(math.Abs(float64(r[1] - r[0]))))
+}
+
+// Temperature will return the equivalent noise temperature of a noise
+// figure, in kelvin.
+func Temperature(nf rf.DB) float64 {
+	return ReferenceTemperature * (nf.Ratio() - 1)
+}
+
+// Figure will return the noise figure of an equivalent noise temperature,
+// in kelvin.
+func Figure(kelvin float64) rf.DB {
+	return rf.DBFromRatio(1 + kelvin/ReferenceTemperature)
+}
+
+// MDS will return the minimum discernible signal of a receiver with the
+// provided noise figure, which is the power at which a signal is equal to
+// the noise in the bandwidth.
+func MDS(nf rf.DB, bandwidth rf.Hz) rf.DBm {
+	return Thermal(bandwidth).Add(nf)
+}
+
+// Sensitivity will return the weakest signal a receiver with the provided
+// noise figure can receive with the required signal to noise ratio.
+func Sensitivity(nf rf.DB, bandwidth rf.Hz, snr rf.DB) rf.DBm {
+	return MDS(nf, bandwidth).Add(snr)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,85 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package noise_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/noise"
+)
+
+func TestThermal(t *testing.T) {
+	assert.InDelta(t, -173.98, float64(noise.Thermal(1)), 0.01)
+	assert.InDelta(t, -113.98, float64(noise.Thermal(rf.MHz)), 0.01)
+	assert.InDelta(t, -130.0, float64(noise.Thermal(rf.KHz*25)), 0.05)
+	assert.Equal(t, noise.Thermal(rf.MHz*2), noise.ThermalRange(rf.Range{rf.MHz * 144, rf.MHz * 146}))
+	assert.InDelta(t, -188.6, float64(noise.ThermalAt(1, 10)), 0.01)
+}
+
+func TestTemperature(t *testing.T) {
+	assert.InDelta(t, 290.0, noise.Temperature(3.0103), 0.01)
+	assert.InDelta(t, 3.0103, float64(noise.Figure(290)), 1e-4)
+	assert.InDelta(t, 0.0, float64(noise.Figure(0)), 1e-12)
+}
+
+func TestSensitivity(t *testing.T) {
+	assert.InDelta(t, -127.98, float64(noise.MDS(6, rf.KHz*10)), 0.01)
+	assert.InDelta(t, -117.98, float64(noise.Sensitivity(6, rf.KHz*10, 10)), 0.01)
+}
+
+func TestCascade(t *testing.T) {
+	c := noise.Chain{
+		{Name: "Cable", Gain: -1, NoiseFigure: 1},
+		{Name: "LNA", Gain: 20, NoiseFigure: 1},
+		{Name: "Mixer", Gain: -7, NoiseFigure: 7},
+		{Name: "IF amplifier", Gain: 30, NoiseFigure: 10},
+	}.Cascade()
+
+	assert.Equal(t, 4, len(c.Stages))
+	assert.InDelta(t, 42.0, float64(c.Gain), 1e-9)
+	assert.InDelta(t, 3.43, float64(c.NoiseFigure), 0.01)
+	assert.InDelta(t, 1.0, float64(c.Stages[0].NoiseFigure), 1e-9)
+	assert.InDelta(t, 2.0, float64(c.Stages[1].NoiseFigure), 0.01)
+	assert.Equal(t, c.NoiseFigure, c.Stages[3].NoiseFigure)
+	assert.Equal(t, c.Gain, c.Stages[3].Gain)
+	assert.Equal(t, "LNA", c.Stages[1].Stage.Name)
+
+	share := 0.0
+	for _, stage := range c.Stages {
+		share += stage.Share
+	}
+	assert.InDelta(t, 1.0, share, 1e-9)
+	assert.True(t, c.Stages[3].Share > c.Stages[0].Share)
+
+	assert.InDelta(t, -130.54, float64(c.MDS(rf.KHz*10)), 0.01)
+	assert.InDelta(t, -120.54, float64(c.Sensitivity(rf.KHz*10, 10)), 0.01)
+	assert.InDelta(t, -88.54, float64(c.NoiseFloor(rf.KHz*10)), 0.01)
+	assert.InDelta(t, noise.Temperature(c.NoiseFigure), c.Temperature(), 1e-9)
+
+	empty := noise.Chain{}.Cascade()
+	assert.Equal(t, rf.DB(0), empty.Gain)
+	assert.Equal(t, rf.DB(0), empty.NoiseFigure)
+}
+
+// vim: foldmethod=marker
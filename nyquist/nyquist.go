@@ -0,0 +1,233 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package nyquist
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"hz.tools/rf"
+)
+
+// Sampler is an analog to digital converter running at a sample Rate.
+type Sampler struct {
+	// Rate is the sample rate, in samples per second.
+	Rate rf.Hz
+
+	// Complex is true for IQ sampling, where the baseband runs from
+	// -Rate/2 to +Rate/2, rather than 0 to Rate/2 for real sampling.
+	Complex bool
+}
+
+// Real will return a Sampler taking real samples at the provided rate.
+func Real(rate rf.Hz) Sampler {
+	return Sampler{Rate: rate}
+}
+
+// IQ will return a Sampler taking complex samples at the provided rate.
+func IQ(rate rf.Hz) Sampler {
+	return Sampler{Rate: rate, Complex: true}
+}
+
+// width will return the width of a Nyquist zone.
+func (s Sampler) width() rf.Hz {
+	if s.Complex {
+		return s.Rate
+	}
+	return s.Rate / 2
+}
+
+// Baseband will return the range of frequencies that can be represented
+// without aliasing.
+func (s Sampler) Baseband() rf.Range {
+	if s.Complex {
+		return rf.Range{-s.Rate / 2, s.Rate / 2}
+	}
+	return rf.Range{0, s.Rate / 2}
+}
+
+// Zone will return the Nyquist zone the frequency is in.
+//
+// For real sampling, zones are numbered from 1, where zone 1 is 0 to
+// Rate/2, zone 2 is Rate/2 to Rate, and so on. For complex sampling, zone k
+// is centered on k×Rate, so zone 0 is the baseband.
+func (s Sampler) Zone(freq rf.Hz) int {
+	if s.Complex {
+		return int(math.Floor(float64((freq + s.Rate/2) / s.Rate)))
+	}
+	return int(math.Floor(float64(freq/s.width()))) + 1
+}
+
+// zoneStart will return the lowest frequency in the Nyquist zone.
+func (s Sampler) zoneStart(zone int) rf.Hz {
+	if s.Complex {
+		return rf.Hz(zone)*s.Rate - s.Rate/2
+	}
+	return rf.Hz(zone-1) * s.width()
+}
+
+// Inverted will return true if signals in the zone are spectrally inverted
+// after sampling, which is the case for even zones with real sampling.
+func (s Sampler) Inverted(zone int) bool {
+	return !s.Complex && zone%2 == 0
+}
+
+// Alias will return the baseband frequency the input frequency aliases to,
+// and whether it's inverted.
+func (s Sampler) Alias(freq rf.Hz) (rf.Hz, bool) {
+	zone := s.Zone(freq)
+	offset := freq - s.zoneStart(zone)
+	inverted := s.Inverted(zone)
+	if inverted {
+		offset = s.width() - offset
+	}
+	return s.Baseband()[0] + offset, inverted
+}
+
+// Alias is where an input Range lands after sampling.
+type Alias struct {
+	// Zone is the Nyquist zone of the input.
+	Zone int
+
+	// Baseband is the range of frequencies after sampling. If the input
+	// Straddles a zone boundary and its Parts don't join up into a single
+	// range, such as a complex input crossing ±Rate/2, this is the zero
+	// Range, and Parts must be used instead.
+	Baseband rf.Range
+
+	// Parts is the range of frequencies after sampling of the part of the
+	// input in each zone it crosses, in order.
+	Parts []rf.Range
+
+	// Inverted is true if the spectrum is inverted after sampling.
+	Inverted bool
+
+	// Straddles is true if the input crosses a zone boundary, in which
+	// case it's split into more than one of the Parts.
+	Straddles bool
+}
+
+// AliasRange will return where the input Range lands after sampling.
+func (s Sampler) AliasRange(r rf.Range) Alias {
+	first := s.Zone(r[0])
+	last := first
+	for s.zoneStart(last+1) < r[1] {
+		last++
+	}
+
+	ret := Alias{
+		Zone:      first,
+		Parts:     []rf.Range{},
+		Inverted:  s.Inverted(first),
+		Straddles: first != last,
+	}
+	for zone := first; zone <= last; zone++ {
+		lo, hi := s.zoneStart(zone), s.zoneStart(zone+1)
+		if r[0] > lo {
+			lo = r[0]
+		}
+		if r[1] < hi {
+			hi = r[1]
+		}
+		a := s.fold(zone, lo)
+		b := s.fold(zone, hi)
+		if a > b {
+			a, b = b, a
+		}
+		ret.Parts = append(ret.Parts, rf.Range{a, b})
+	}
+	ret.Baseband, _ = union(ret.Parts)
+	return ret
+}
+
+// union will return the single Range covered by every part, or false if
+// there are gaps between them.
+func union(parts []rf.Range) (rf.Range, bool) {
+	sorted := append([]rf.Range{}, parts...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0]
+	})
+	ret := sorted[0]
+	for _, part := range sorted[1:] {
+		if part[0] > ret[1] {
+			return rf.Range{}, false
+		}
+		if part[1] > ret[1] {
+			ret[1] = part[1]
+		}
+	}
+	return ret, true
+}
+
+// fold will return the baseband frequency of freq, which must be within
+// (or at the edge of) the zone.
+func (s Sampler) fold(zone int, freq rf.Hz) rf.Hz {
+	offset := freq - s.zoneStart(zone)
+	if s.Inverted(zone) {
+		offset = s.width() - offset
+	}
+	return s.Baseband()[0] + offset
+}
+
+// Sources will return every band of input frequencies, from 0 up to limit,
+// that alias onto the provided baseband Range, one per Nyquist zone. The
+// Metadata of each rf.Allocation is an *Alias, which can be read with
+// AliasOf.
+func (s Sampler) Sources(baseband rf.Range, limit rf.Hz) rf.Allocations {
+	ret := rf.Allocations{}
+	for zone := s.Zone(0); s.zoneStart(zone) <= limit; zone++ {
+		start := s.zoneStart(zone)
+		lo := start + baseband[0] - s.Baseband()[0]
+		hi := start + baseband[1] - s.Baseband()[0]
+		inverted := s.Inverted(zone)
+		if inverted {
+			end := start + s.width()
+			lo, hi = end-(hi-start), end-(lo-start)
+		}
+		if hi < 0 || lo > limit {
+			continue
+		}
+		ret = append(ret, rf.Allocation{
+			Name:  fmt.Sprintf("Zone %d", zone),
+			Range: rf.Range{lo, hi},
+			Metadata: &Alias{
+				Zone:     zone,
+				Baseband: baseband,
+				Parts:    []rf.Range{baseband},
+				Inverted: inverted,
+			},
+		})
+	}
+	return ret
+}
+
+// AliasOf will return a copy of the Alias of an rf.Allocation returned by
+// Sources, and false if the rf.Allocation doesn't have an Alias.
+func AliasOf(a rf.Allocation) (Alias, bool) {
+	alias, ok := a.Metadata.(*Alias)
+	if !ok || alias == nil {
+		return Alias{}, false
+	}
+	return *alias, true
+}
+
+// vim: foldmethod=marker
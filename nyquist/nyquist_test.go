@@ -0,0 +1,112 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package nyquist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/nyquist"
+)
+
+func TestRealSampling(t *testing.T) {
+	s := nyquist.Real(rf.MHz * 100)
+	assert.Equal(t, 1, s.Zone(rf.MHz*10))
+	assert.Equal(t, 2, s.Zone(rf.MHz*70))
+	assert.Equal(t, 3, s.Zone(rf.MHz*140))
+
+	f, inverted := s.Alias(rf.MHz * 70)
+	assert.Equal(t, rf.MHz*30, f)
+	assert.True(t, inverted)
+
+	f, inverted = s.Alias(rf.MHz * 140)
+	assert.Equal(t, rf.MHz*40, f)
+	assert.False(t, inverted)
+
+	alias := s.AliasRange(rf.Range{rf.MHz * 60, rf.MHz * 80})
+	assert.Equal(t, 2, alias.Zone)
+	assert.Equal(t, rf.Range{rf.MHz * 20, rf.MHz * 40}, alias.Baseband)
+	assert.True(t, alias.Inverted)
+	assert.False(t, alias.Straddles)
+
+	alias = s.AliasRange(rf.Range{rf.MHz * 90, rf.MHz * 110})
+	assert.True(t, alias.Straddles)
+	assert.Equal(t, rf.Range{rf.MHz * 0, rf.MHz * 10}, alias.Baseband)
+	assert.Equal(t, []rf.Range{
+		{rf.MHz * 0, rf.MHz * 10},
+		{rf.MHz * 0, rf.MHz * 10},
+	}, alias.Parts)
+
+	alias = s.AliasRange(rf.Range{rf.MHz * 40, rf.MHz * 50})
+	assert.False(t, alias.Straddles)
+	assert.Equal(t, rf.Range{rf.MHz * 40, rf.MHz * 50}, alias.Baseband)
+}
+
+func TestComplexSampling(t *testing.T) {
+	s := nyquist.IQ(rf.MHz * 10)
+	assert.Equal(t, 0, s.Zone(rf.MHz*4))
+	assert.Equal(t, 0, s.Zone(-rf.MHz*4))
+	assert.Equal(t, 1, s.Zone(rf.MHz*7))
+
+	f, inverted := s.Alias(rf.MHz * 7)
+	assert.Equal(t, -rf.MHz*3, f)
+	assert.False(t, inverted)
+
+	alias := s.AliasRange(rf.Range{rf.MHz * 4, rf.MHz * 6})
+	assert.True(t, alias.Straddles)
+	assert.Equal(t, rf.Range{}, alias.Baseband)
+	assert.Equal(t, []rf.Range{
+		{rf.MHz * 4, rf.MHz * 5},
+		{-rf.MHz * 5, -rf.MHz * 4},
+	}, alias.Parts)
+}
+
+func TestSources(t *testing.T) {
+	s := nyquist.Real(rf.MHz * 100)
+	sources := s.Sources(rf.Range{rf.MHz * 20, rf.MHz * 30}, rf.MHz*200)
+	assert.Equal(t, 4, len(sources))
+	assert.Equal(t, rf.Range{rf.MHz * 20, rf.MHz * 30}, sources[0].Range)
+	assert.Equal(t, rf.Range{rf.MHz * 70, rf.MHz * 80}, sources[1].Range)
+	assert.Equal(t, rf.Range{rf.MHz * 120, rf.MHz * 130}, sources[2].Range)
+	assert.Equal(t, rf.Range{rf.MHz * 170, rf.MHz * 180}, sources[3].Range)
+	alias, ok := nyquist.AliasOf(sources[1])
+	assert.True(t, ok)
+	assert.True(t, alias.Inverted)
+	assert.Equal(t, 2, alias.Zone)
+	assert.True(t, sources[1] == sources[1])
+
+	_, ok = nyquist.AliasOf(rf.Allocation{})
+	assert.False(t, ok)
+
+	for _, source := range sources {
+		alias := s.AliasRange(source.Range)
+		assert.Equal(t, rf.Range{rf.MHz * 20, rf.MHz * 30}, alias.Baseband)
+	}
+
+	iq := nyquist.IQ(rf.MHz * 10)
+	sources = iq.Sources(rf.Range{rf.MHz * 1, rf.MHz * 2}, rf.MHz*30)
+	assert.Equal(t, 3, len(sources))
+	assert.Equal(t, rf.Range{rf.MHz * 11, rf.MHz * 12}, sources[1].Range)
+}
+
+// vim: foldmethod=marker
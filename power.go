@@ -0,0 +1,579 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package rf
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DB is a ratio between two powers, in decibels, such as a gain or a loss.
+type DB float64
+
+// DBm is a power, in decibels relative to one milliwatt.
+type DBm float64
+
+// DBW is a power, in decibels relative to one watt.
+type DBW float64
+
+// DBuV is a voltage, in decibels relative to one microvolt, commonly used
+// for field strength and receiver input levels. Converting to or from a
+// power requires the impedance the voltage is measured across.
+type DBuV float64
+
+// Watts is a power, in watts.
+type Watts float64
+
+// Volts is an RMS voltage, in volts.
+type Volts float64
+
+// DBFromRatio will return the power ratio in decibels.
+func DBFromRatio(ratio float64) DB {
+	return DB(10 * math.Log10(ratio))
+}
+
+// Ratio will return the power ratio the decibels represent, such as 2 for
+// about 3dB.
+func (d DB) Ratio() float64 {
+	return math.Pow(10, float64(d)/10)
+}
+
+// String will convert the ratio into a string, such as "3dB".
+func (d DB) String() string {
+	return formatLevel(float64(d), "dB")
+}
+
+// Watts will return the power in watts.
+func (p DBm) Watts() Watts {
+	return Watts(math.Pow(10, float64(p)/10) / 1000)
+}
+
+// DBW will return the power in dBW, which is 30dB less than dBm.
+func (p DBm) DBW() DBW {
+	return DBW(p - 30)
+}
+
+// DBuV will return the voltage this power develops across the provided
+// impedance, in ohms. In a 50 ohm system, this is about 107dB more than
+// the power in dBm.
+func (p DBm) DBuV(ohms float64) DBuV {
+	return p.Watts().Volts(ohms).DBuV()
+}
+
+// Add will apply a gain (or, if negative, a loss) to the power.
+func (p DBm) Add(gain DB) DBm {
+	return p + DBm(gain)
+}
+
+// Sub will return the ratio between two powers.
+func (p DBm) Sub(o DBm) DB {
+	return DB(p - o)
+}
+
+// Combine will return the total power of two uncorrelated signals, such as
+// a signal and noise, by adding them in watts.
+func (p DBm) Combine(o DBm) DBm {
+	return (p.Watts() + o.Watts()).DBm()
+}
+
+// String will convert the power into a string, such as "-100dBm".
+func (p DBm) String() string {
+	return formatLevel(float64(p), "dBm")
+}
+
+// Watts will return the power in watts.
+func (p DBW) Watts() Watts {
+	return Watts(math.Pow(10, float64(p)/10))
+}
+
+// DBm will return the power in dBm, which is 30dB more than dBW.
+func (p DBW) DBm() DBm {
+	return DBm(p + 30)
+}
+
+// Add will apply a gain (or, if negative, a loss) to the power.
+func (p DBW) Add(gain DB) DBW {
+	return p + DBW(gain)
+}
+
+// Sub will return the ratio between two powers.
+func (p DBW) Sub(o DBW) DB {
+	return DB(p - o)
+}
+
+// String will convert the power into a string, such as "10dBW".
+func (p DBW) String() string {
+	return formatLevel(float64(p), "dBW")
+}
+
+// DBm will return the power in dBm.
+func (p Watts) DBm() DBm {
+	return DBm(10 * math.Log10(float64(p)*1000))
+}
+
+// DBW will return the power in dBW.
+func (p Watts) DBW() DBW {
+	return DBW(10 * math.Log10(float64(p)))
+}
+
+// Volts will return the RMS voltage this power develops across the
+// provided impedance, in ohms.
+func (p Watts) Volts(ohms float64) Volts {
+	return Volts(math.Sqrt(float64(p) * ohms))
+}
+
+// String will convert the power into a string, using the closest SI
+// prefix, such as "100mW" or "1.5kW".
+func (p Watts) String() string {
+	return formatSI(float64(p), "W")
+}
+
+// Watts will return the power this voltage develops across the provided
+// impedance, in ohms.
+func (v Volts) Watts(ohms float64) Watts {
+	return Watts(float64(v) * float64(v) / ohms)
+}
+
+// DBm will return the power this voltage develops across the provided
+// impedance, in ohms.
+func (v Volts) DBm(ohms float64) DBm {
+	return v.Watts(ohms).DBm()
+}
+
+// DBuV will return the voltage in dBμV.
+func (v Volts) DBuV() DBuV {
+	return DBuV(20 * math.Log10(float64(v)*1e6))
+}
+
+// String will convert the voltage into a string, using the closest SI
+// prefix, such as "1uV" or "2.5V".
+func (v Volts) String() string {
+	return formatSI(float64(v), "V")
+}
+
+// Volts will return the voltage in volts.
+func (v DBuV) Volts() Volts {
+	return Volts(math.Pow(10, float64(v)/20) / 1e6)
+}
+
+// DBm will return the power this voltage develops across the provided
+// impedance, in ohms.
+func (v DBuV) DBm(ohms float64) DBm {
+	return v.Volts().DBm(ohms)
+}
+
+// Add will apply a gain (or, if negative, a loss) to the voltage.
+func (v DBuV) Add(gain DB) DBuV {
+	return v + DBuV(gain)
+}
+
+// Sub will return the ratio between two voltages.
+func (v DBuV) Sub(o DBuV) DB {
+	return DB(v - o)
+}
+
+// String will convert the voltage into a string, such as "20dBuV".
+func (v DBuV) String() string {
+	return formatLevel(float64(v), "dBuV")
+}
+
+// MustParsePower will run the string through ParsePower, and on error,
+// panic.
+func MustParsePower(power string) DBm {
+	p, err := ParsePower(power)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// ParsePower will take a power as a string, and return it in dBm.
+//
+// Examples of valid powers:
+//
+// -100dBm
+// 10dBW
+// 5W
+// 100mW
+//
+// Valid units are 'dBm', 'dBW', and 'W' with an SI prefix from 'p' to 'G'.
+// Powers in watts must be positive, since zero or negative watts can't be
+// expressed in dBm.
+func ParsePower(power string) (DBm, error) {
+	l, err := parseLevel(power)
+	if err != nil {
+		return 0, err
+	}
+	return l.dbm()
+}
+
+// level is a parsed value, along with the unit it was written in. Values
+// in watts or volts have already been scaled by their SI prefix.
+type level struct {
+	value float64
+	unit  string
+}
+
+// levelPattern matches a number followed by a unit.
+var levelPattern = regexp.MustCompile(`^([-+]?(?:[0-9]+\.?[0-9]*|\.[0-9]+)(?:[eE][-+]?[0-9]+)?)\s*([A-Za-zµμ]+)$`)
+
+// siPrefixes are the SI prefixes used when parsing and formatting watts
+// and volts, in ascending order.
+var siPrefixes = []struct {
+	prefix string
+	scale  float64
+}{
+	{"p", 1e-12},
+	{"n", 1e-9},
+	{"u", 1e-6},
+	{"m", 1e-3},
+	{"", 1},
+	{"k", 1e3},
+	{"M", 1e6},
+	{"G", 1e9},
+}
+
+// parseLevel will parse a value and its unit, such as "-100dBm" or "5mW".
+func parseLevel(s string) (level, error) {
+	match := levelPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return level{}, fmt.Errorf("rf: invalid level: %s", s)
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return level{}, fmt.Errorf("rf: invalid level: %s", s)
+	}
+	unit := strings.NewReplacer("µ", "u", "μ", "u").Replace(match[2])
+
+	switch strings.ToLower(unit) {
+	case "db":
+		return level{value, "dB"}, nil
+	case "dbm":
+		return level{value, "dBm"}, nil
+	case "dbw":
+		return level{value, "dBW"}, nil
+	case "dbuv":
+		return level{value, "dBuV"}, nil
+	}
+
+	base := unit[len(unit)-1:]
+	if base != "W" && base != "V" {
+		return level{}, fmt.Errorf("rf: unknown unit: %s", match[2])
+	}
+	for _, si := range siPrefixes {
+		if unit[:len(unit)-1] == si.prefix {
+			return level{value * si.scale, base}, nil
+		}
+	}
+	return level{}, fmt.Errorf("rf: unknown unit: %s", match[2])
+}
+
+// dbm will return the level as a power in dBm.
+func (l level) dbm() (DBm, error) {
+	switch l.unit {
+	case "dBm":
+		return DBm(l.value), nil
+	case "dBW":
+		return DBW(l.value).DBm(), nil
+	case "W":
+		if l.value <= 0 {
+			return 0, fmt.Errorf("rf: power must be positive: %gW", l.value)
+		}
+		return Watts(l.value).DBm(), nil
+	}
+	return 0, fmt.Errorf("rf: not a power: %s", l.unit)
+}
+
+// dbw will return the level as a power in dBW.
+func (l level) dbw() (DBW, error) {
+	if l.unit == "dBW" {
+		return DBW(l.value), nil
+	}
+	p, err := l.dbm()
+	return p.DBW(), err
+}
+
+// watts will return the level as a power in watts. Unlike dbm, zero watts
+// is allowed, since it's representable.
+func (l level) watts() (Watts, error) {
+	if l.unit == "W" && l.value < 0 {
+		return 0, fmt.Errorf("rf: power must not be negative: %gW", l.value)
+	}
+	if l.unit == "W" {
+		return Watts(l.value), nil
+	}
+	p, err := l.dbm()
+	return p.Watts(), err
+}
+
+// as will return the level's value, ensuring it's in the provided unit.
+func (l level) as(unit string) (float64, error) {
+	if l.unit != unit {
+		return 0, fmt.Errorf("rf: expected %s, got %s", unit, l.unit)
+	}
+	return l.value, nil
+}
+
+// levelPrecision is the number of decimal places a logarithmic value is
+// rounded to when formatted, which hides floating point error such as
+// -103.39999999999999.
+const levelPrecision = 1000
+
+// formatLevel will format a logarithmic value and its unit, rounded to
+// three decimal places.
+func formatLevel(value float64, unit string) string {
+	value = math.Round(value*levelPrecision) / levelPrecision
+	if value == 0 {
+		// Avoid "-0dB".
+		value = 0
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64) + unit
+}
+
+// formatSI will format a linear value using the largest SI prefix that
+// keeps it at or above one.
+func formatSI(value float64, unit string) string {
+	var (
+		magnitude = math.Abs(value)
+		prefix    = siPrefixes[0]
+	)
+	if magnitude == 0 {
+		return "0" + unit
+	}
+	for _, si := range siPrefixes {
+		if magnitude >= si.scale {
+			prefix = si
+		}
+	}
+	return strconv.FormatFloat(value/prefix.scale, 'g', 12, 64) + prefix.prefix + unit
+}
+
+// unmarshalLevel will parse a JSON string as a level.
+func unmarshalLevel(data []byte) (level, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return level{}, err
+	}
+	return parseLevel(s)
+}
+
+// unmarshalYAMLLevel will parse a YAML string as a level.
+func unmarshalYAMLLevel(unmarshal func(interface{}) error) (level, error) {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return level{}, err
+	}
+	return parseLevel(s)
+}
+
+// MarshalJSON will convert the ratio to a string.
+func (d DB) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// MarshalYAML will convert the ratio to a string.
+func (d DB) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// UnmarshalJSON will parse a string such as "3dB" as a ratio.
+func (d *DB) UnmarshalJSON(data []byte) error {
+	l, err := unmarshalLevel(data)
+	if err != nil {
+		return err
+	}
+	v, err := l.as("dB")
+	*d = DB(v)
+	return err
+}
+
+// UnmarshalYAML will parse a string such as "3dB" as a ratio.
+func (d *DB) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	l, err := unmarshalYAMLLevel(unmarshal)
+	if err != nil {
+		return err
+	}
+	v, err := l.as("dB")
+	*d = DB(v)
+	return err
+}
+
+// MarshalJSON will convert the power to a string.
+func (p DBm) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// MarshalYAML will convert the power to a string.
+func (p DBm) MarshalYAML() (interface{}, error) {
+	return p.String(), nil
+}
+
+// UnmarshalJSON will parse a power in any unit ParsePower accepts.
+func (p *DBm) UnmarshalJSON(data []byte) error {
+	l, err := unmarshalLevel(data)
+	if err != nil {
+		return err
+	}
+	*p, err = l.dbm()
+	return err
+}
+
+// UnmarshalYAML will parse a power in any unit ParsePower accepts.
+func (p *DBm) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	l, err := unmarshalYAMLLevel(unmarshal)
+	if err != nil {
+		return err
+	}
+	*p, err = l.dbm()
+	return err
+}
+
+// MarshalJSON will convert the power to a string.
+func (p DBW) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// MarshalYAML will convert the power to a string.
+func (p DBW) MarshalYAML() (interface{}, error) {
+	return p.String(), nil
+}
+
+// UnmarshalJSON will parse a power in any unit ParsePower accepts.
+func (p *DBW) UnmarshalJSON(data []byte) error {
+	l, err := unmarshalLevel(data)
+	if err != nil {
+		return err
+	}
+	*p, err = l.dbw()
+	return err
+}
+
+// UnmarshalYAML will parse a power in any unit ParsePower accepts.
+func (p *DBW) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	l, err := unmarshalYAMLLevel(unmarshal)
+	if err != nil {
+		return err
+	}
+	*p, err = l.dbw()
+	return err
+}
+
+// MarshalJSON will convert the power to a string.
+func (p Watts) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// MarshalYAML will convert the power to a string.
+func (p Watts) MarshalYAML() (interface{}, error) {
+	return p.String(), nil
+}
+
+// UnmarshalJSON will parse a power in any unit ParsePower accepts, or zero
+// watts.
+func (p *Watts) UnmarshalJSON(data []byte) error {
+	l, err := unmarshalLevel(data)
+	if err != nil {
+		return err
+	}
+	*p, err = l.watts()
+	return err
+}
+
+// UnmarshalYAML will parse a power in any unit ParsePower accepts, or zero
+// watts.
+func (p *Watts) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	l, err := unmarshalYAMLLevel(unmarshal)
+	if err != nil {
+		return err
+	}
+	*p, err = l.watts()
+	return err
+}
+
+// MarshalJSON will convert the voltage to a string.
+func (v Volts) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// MarshalYAML will convert the voltage to a string.
+func (v Volts) MarshalYAML() (interface{}, error) {
+	return v.String(), nil
+}
+
+// UnmarshalJSON will parse a string such as "1.5mV" as a voltage.
+func (v *Volts) UnmarshalJSON(data []byte) error {
+	l, err := unmarshalLevel(data)
+	if err != nil {
+		return err
+	}
+	f, err := l.as("V")
+	*v = Volts(f)
+	return err
+}
+
+// UnmarshalYAML will parse a string such as "1.5mV" as a voltage.
+func (v *Volts) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	l, err := unmarshalYAMLLevel(unmarshal)
+	if err != nil {
+		return err
+	}
+	f, err := l.as("V")
+	*v = Volts(f)
+	return err
+}
+
+// MarshalJSON will convert the voltage to a string.
+func (v DBuV) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// MarshalYAML will convert the voltage to a string.
+func (v DBuV) MarshalYAML() (interface{}, error) {
+	return v.String(), nil
+}
+
+// UnmarshalJSON will parse a string such as "20dBuV" as a voltage.
+func (v *DBuV) UnmarshalJSON(data []byte) error {
+	l, err := unmarshalLevel(data)
+	if err != nil {
+		return err
+	}
+	f, err := l.as("dBuV")
+	*v = DBuV(f)
+	return err
+}
+
+// UnmarshalYAML will parse a string such as "20dBuV" as a voltage.
+func (v *DBuV) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	l, err := unmarshalYAMLLevel(unmarshal)
+	if err != nil {
+		return err
+	}
+	f, err := l.as("dBuV")
+	*v = DBuV(f)
+	return err
+}
+
+// vim: foldmethod=marker
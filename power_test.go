@@ -0,0 +1,182 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package rf_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+)
+
+func TestPowerConversions(t *testing.T) {
+	assert.InDelta(t, 1.0, float64(rf.DBm(30).Watts()), 1e-12)
+	assert.Equal(t, rf.DBW(0), rf.DBm(30).DBW())
+	assert.Equal(t, rf.DBm(30), rf.DBW(0).DBm())
+	assert.InDelta(t, 20.0, float64(rf.Watts(0.1).DBm()), 1e-12)
+	assert.InDelta(t, 6.99, float64(rf.Watts(5).DBW()), 0.01)
+	assert.InDelta(t, 2.0, rf.DB(3).Ratio(), 0.01)
+	assert.InDelta(t, 3.0103, float64(rf.DBFromRatio(2)), 1e-4)
+	assert.True(t, math.IsInf(float64(rf.Watts(0).DBm()), -1))
+}
+
+func TestVoltageConversions(t *testing.T) {
+	// 0dBm into 50 ohms is about 224mV, or 107dBuV.
+	assert.InDelta(t, 0.2236, float64(rf.DBm(0).Watts().Volts(50)), 1e-4)
+	assert.InDelta(t, 106.99, float64(rf.DBm(0).DBuV(50)), 0.01)
+	assert.InDelta(t, -6.99, float64(rf.DBuV(100).DBm(50)), 0.01)
+	assert.InDelta(t, -106.99, float64(rf.DBuV(0).DBm(50)), 0.01)
+
+	// and into 75 ohms, about 108.75dBuV.
+	assert.InDelta(t, 108.75, float64(rf.DBm(0).DBuV(75)), 0.01)
+
+	assert.InDelta(t, 1e-6, float64(rf.DBuV(0).Volts()), 1e-15)
+	assert.InDelta(t, 120.0, float64(rf.Volts(1).DBuV()), 1e-12)
+	assert.InDelta(t, 0.02, float64(rf.Volts(1).Watts(50)), 1e-12)
+}
+
+func TestPowerArithmetic(t *testing.T) {
+	assert.Equal(t, rf.DBm(-70), rf.DBm(-100).Add(30))
+	assert.Equal(t, rf.DBm(-103), rf.DBm(-100).Add(-3))
+	assert.Equal(t, rf.DB(20), rf.DBm(-80).Sub(-100))
+	assert.Equal(t, rf.DBW(13), rf.DBW(10).Add(3))
+	assert.Equal(t, rf.DB(-3), rf.DBW(7).Sub(10))
+	assert.Equal(t, rf.DB(6), rf.DBuV(46).Sub(40))
+	assert.Equal(t, rf.DBuV(40), rf.DBuV(46).Add(-6))
+	assert.InDelta(t, -96.99, float64(rf.DBm(-100).Combine(-100)), 0.01)
+}
+
+func TestPowerString(t *testing.T) {
+	assert.Equal(t, "-100dBm", rf.DBm(-100).String())
+	assert.Equal(t, "10.5dBW", rf.DBW(10.5).String())
+	assert.Equal(t, "3dB", rf.DB(3).String())
+	assert.Equal(t, "20dBuV", rf.DBuV(20).String())
+	assert.Equal(t, "0.3dBm", rf.DBm(0.1).Add(0.2).String())
+	assert.Equal(t, "3.01dB", rf.DBFromRatio(2).String())
+	assert.Equal(t, "0dBm", rf.DBm(-0.0001).String())
+	assert.Equal(t, "100mW", rf.Watts(0.1).String())
+	assert.Equal(t, "1mW", rf.Watts(0.001).String())
+	assert.Equal(t, "1.5kW", rf.Watts(1500).String())
+	assert.Equal(t, "5W", rf.Watts(5).String())
+	assert.Equal(t, "0W", rf.Watts(0).String())
+	assert.Equal(t, "-2mV", rf.Volts(-0.002).String())
+	assert.Equal(t, "1uV", rf.Volts(1e-6).String())
+}
+
+func TestParsePower(t *testing.T) {
+	for power, dbm := range map[string]float64{
+		"-100dBm": -100,
+		"+10dbm":  10,
+		"0dBW":    30,
+		"-3.5dBW": 26.5,
+		"1W":      30,
+		"100mW":   20,
+		"1kW":     60,
+		"1µW":     -30,
+		"1uW":     -30,
+		"1 mW":    0,
+	} {
+		p, err := rf.ParsePower(power)
+		assert.NoError(t, err, power)
+		assert.InDelta(t, dbm, float64(p), 1e-9, power)
+	}
+
+	for _, power := range []string{"", "dBm", "10", "10dB", "10dBuV", "10V", "10xW", "10Hz", "0W", "-5W", "-5mW"} {
+		_, err := rf.ParsePower(power)
+		assert.Error(t, err, power)
+	}
+	assert.Panics(t, func() { rf.MustParsePower("10") })
+}
+
+func TestPowerJSON(t *testing.T) {
+	type budget struct {
+		Power   rf.Watts
+		EIRP    rf.DBm
+		Gain    rf.DB
+		Level   rf.DBuV
+		Voltage rf.Volts
+		Limit   rf.DBW
+	}
+
+	b := budget{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"Power": "5W",
+		"EIRP": "10dBW",
+		"Gain": "-3dB",
+		"Level": "40dBuV",
+		"Voltage": "1.5mV",
+		"Limit": "100mW"
+	}`), &b))
+	assert.Equal(t, rf.Watts(5), b.Power)
+	assert.Equal(t, rf.DBm(40), b.EIRP)
+	assert.Equal(t, rf.DB(-3), b.Gain)
+	assert.Equal(t, rf.DBuV(40), b.Level)
+	assert.Equal(t, rf.Volts(1.5e-3), b.Voltage)
+	assert.InDelta(t, -10.0, float64(b.Limit), 1e-9)
+
+	data, err := json.Marshal(budget{Power: 0.1, EIRP: -3, Gain: 6, Level: 20, Voltage: 2, Limit: 10})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"Power": "100mW",
+		"EIRP": "-3dBm",
+		"Gain": "6dB",
+		"Level": "20dBuV",
+		"Voltage": "2V",
+		"Limit": "10dBW"
+	}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Gain": "3dBm"}`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`{"EIRP": "-5W"}`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`{"Power": "-5W"}`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`{"Voltage": "3W"}`), &b))
+}
+
+func TestZeroWatts(t *testing.T) {
+	data, err := json.Marshal(rf.Watts(0))
+	assert.NoError(t, err)
+	assert.Equal(t, `"0W"`, string(data))
+
+	power := rf.Watts(1)
+	assert.NoError(t, json.Unmarshal(data, &power))
+	assert.Equal(t, rf.Watts(0), power)
+
+	value, err := rf.Watts(0).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, "0W", value)
+
+	power = rf.Watts(1)
+	assert.NoError(t, power.UnmarshalYAML(func(v interface{}) error {
+		*(v.(*string)) = value.(string)
+		return nil
+	}))
+	assert.Equal(t, rf.Watts(0), power)
+
+	// Zero watts has no logarithmic equivalent.
+	var dbm rf.DBm
+	assert.Error(t, json.Unmarshal(data, &dbm))
+	var dbw rf.DBW
+	assert.Error(t, json.Unmarshal(data, &dbw))
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,168 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package prs
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+// Service is the name of a Personal Radio Service, such as "FRS" or "GMRS".
+type Service string
+
+// Channel is a single channel within a Personal Radio Service.
+type Channel struct {
+	// Service this channel belongs to.
+	Service Service
+
+	// Number is the channel number, as it would be labeled on a radio.
+	Number int
+
+	// Repeater is set if this channel is a repeater input, such as GMRS
+	// channel "15R".
+	Repeater bool
+
+	// Frequency is the center frequency of the channel.
+	Frequency rf.Hz
+
+	// MaxPower is the maximum permitted transmit power. Depending on the
+	// Service this is either output power or ERP.
+	MaxPower rf.Watts
+
+	// Bandwidth is the maximum permitted occupied bandwidth.
+	Bandwidth rf.Hz
+}
+
+// String will return the Service and channel designator, such as "GMRS 15R".
+func (c Channel) String() string {
+	if c.Repeater {
+		return fmt.Sprintf("%s %dR", c.Service, c.Number)
+	}
+	return fmt.Sprintf("%s %d", c.Service, c.Number)
+}
+
+// Range will return the range of frequencies the channel is permitted to
+// occupy.
+func (c Channel) Range() rf.Range {
+	half := c.Bandwidth / 2
+	return rf.Range{-half, half}.Add(c.Frequency)
+}
+
+// Allocation will return the channel as an rf.Allocation, with the Channel
+// set as the Metadata.
+func (c Channel) Allocation() rf.Allocation {
+	return rf.Allocation{
+		Name:     c.String(),
+		Range:    c.Range(),
+		Metadata: c,
+	}
+}
+
+// ChannelOf will return the Channel of an rf.Allocation returned by Channel.Allocation, and false if the
+// rf.Allocation doesn't have one.
+func ChannelOf(a rf.Allocation) (Channel, bool) {
+	c, ok := a.Metadata.(Channel)
+	return c, ok
+}
+
+// Channels is a list of Channel objects, usually all the channels of a
+// single Service.
+type Channels []Channel
+
+// Allocations will return all the Channels as rf.Allocations.
+func (c Channels) Allocations() rf.Allocations {
+	ret := rf.Allocations{}
+	for _, channel := range c {
+		ret = append(ret, channel.Allocation())
+	}
+	return ret
+}
+
+// Channel will return the channel with the provided number, or false if no
+// such channel exists. Repeater inputs are only returned if there's no
+// other channel with that number, such as UHF CB channel 31, so GMRS
+// channel 15 is the simplex channel rather than the repeater input "15R".
+func (c Channels) Channel(number int) (Channel, bool) {
+	var (
+		input Channel
+		found bool
+	)
+	for _, channel := range c {
+		if channel.Number != number {
+			continue
+		}
+		if !channel.Repeater {
+			return channel, true
+		}
+		if !found {
+			input, found = channel, true
+		}
+	}
+	return input, found
+}
+
+// Lookup will return every Channel, from every Service in AllChannels,
+// whose permitted range contains the provided frequency.
+func Lookup(freq rf.Hz) Channels {
+	ret := Channels{}
+	for _, channel := range AllChannels {
+		if channel.Range().ContainsFrequency(freq) {
+			ret = append(ret, channel)
+		}
+	}
+	return ret
+}
+
+// channelPlan is a helper to build a regular block of channels, starting at
+// the provided channel number and frequency, and stepping by step.
+func channelPlan(
+	service Service,
+	number int,
+	start rf.Hz,
+	step rf.Hz,
+	count int,
+	power rf.Watts,
+	bandwidth rf.Hz,
+) Channels {
+	ret := Channels{}
+	for i := 0; i < count; i++ {
+		ret = append(ret, Channel{
+			Service:   service,
+			Number:    number + i,
+			Frequency: start + step*rf.Hz(i),
+			MaxPower:  power,
+			Bandwidth: bandwidth,
+		})
+	}
+	return ret
+}
+
+// join will concatenate a number of Channels together.
+func join(channels ...Channels) Channels {
+	ret := Channels{}
+	for _, c := range channels {
+		ret = append(ret, c...)
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,114 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package prs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/prs"
+)
+
+func TestChannelCounts(t *testing.T) {
+	assert.Equal(t, 22, len(prs.FRS))
+	assert.Equal(t, 30, len(prs.GMRS))
+	assert.Equal(t, 5, len(prs.MURS))
+	assert.Equal(t, 40, len(prs.CB))
+	assert.Equal(t, 16, len(prs.PMR446))
+	assert.Equal(t, 32, len(prs.DPMR446))
+	assert.Equal(t, 80, len(prs.UHFCB))
+}
+
+func TestChannelFrequencies(t *testing.T) {
+	c, ok := prs.CB.Channel(19)
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*27185, c.Frequency)
+
+	c, ok = prs.CB.Channel(40)
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*27405, c.Frequency)
+
+	c, ok = prs.FRS.Channel(22)
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*462725, c.Frequency)
+
+	c, ok = prs.PMR446.Channel(16)
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*446193.75, c.Frequency)
+
+	c, ok = prs.UHFCB.Channel(80)
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*477412.5, c.Frequency)
+
+	_, ok = prs.MURS.Channel(6)
+	assert.False(t, ok)
+}
+
+func TestGMRSRepeater(t *testing.T) {
+	input := prs.GMRS[len(prs.GMRS)-1]
+	assert.True(t, input.Repeater)
+	assert.Equal(t, "GMRS 22R", input.String())
+	assert.Equal(t, rf.KHz*467725, input.Frequency)
+}
+
+func TestUHFCBRepeater(t *testing.T) {
+	for _, c := range prs.UHFCB {
+		repeater := (c.Number >= 31 && c.Number <= 38) || (c.Number >= 71 && c.Number <= 78)
+		assert.Equal(t, repeater, c.Repeater, "%s", c)
+	}
+
+	input, ok := prs.UHFCB.Channel(31)
+	assert.True(t, ok)
+	assert.True(t, input.Repeater)
+	assert.Equal(t, rf.KHz*477175, input.Frequency)
+
+	output, ok := prs.UHFCB.Channel(1)
+	assert.True(t, ok)
+	assert.Equal(t, rf.MHz*0.75, input.Frequency-output.Frequency)
+
+	input, ok = prs.UHFCB.Channel(78)
+	assert.True(t, ok)
+	assert.True(t, input.Repeater)
+	assert.Equal(t, rf.KHz*477362.5, input.Frequency)
+
+	c, ok := prs.GMRS.Channel(15)
+	assert.True(t, ok)
+	assert.False(t, c.Repeater)
+}
+
+func TestLookup(t *testing.T) {
+	channels := prs.Lookup(rf.KHz * 462562.5)
+	assert.Equal(t, 2, len(channels))
+	assert.Equal(t, "FRS 1", channels[0].String())
+	assert.Equal(t, "GMRS 1", channels[1].String())
+	assert.Equal(t, rf.Watts(0.5), prs.Lookup(rf.KHz * 467562.5)[0].MaxPower)
+	assert.Equal(t, len(prs.All), len(prs.AllChannels))
+
+	allocation := prs.All.ContainingFrequency(rf.KHz * 151820).First()
+	assert.Equal(t, "MURS 1", allocation.Name)
+	channel, ok := prs.ChannelOf(allocation)
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*11.25, channel.Bandwidth)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,159 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package prs
+
+import (
+	"hz.tools/rf"
+)
+
+const (
+	// ServiceFRS is the US Family Radio Service.
+	ServiceFRS Service = "FRS"
+
+	// ServiceGMRS is the US General Mobile Radio Service.
+	ServiceGMRS Service = "GMRS"
+
+	// ServiceMURS is the US Multi-Use Radio Service.
+	ServiceMURS Service = "MURS"
+
+	// ServiceCB is the 27MHz Citizens Band, as used in the US and CEPT
+	// countries.
+	ServiceCB Service = "CB"
+
+	// ServiceUKCB is the UK specific 27/81 Citizens Band allocation.
+	ServiceUKCB Service = "UK CB"
+
+	// ServicePMR446 is the European analog PMR446 service.
+	ServicePMR446 Service = "PMR446"
+
+	// ServiceDPMR446 is the European digital (dPMR446 and DMR446) service.
+	ServiceDPMR446 Service = "dPMR446"
+
+	// ServiceUHFCB is the Australian UHF Citizens Band.
+	ServiceUHFCB Service = "UHF CB"
+
+	// ServiceLPD433 is the European 433MHz Low Power Device allocation.
+	ServiceLPD433 Service = "LPD433"
+)
+
+var (
+	// FRS are the 22 channels of the US Family Radio Service (47 CFR 95
+	// Subpart B). Channels 8-14 are limited to 0.5W ERP.
+	FRS = join(
+		channelPlan(ServiceFRS, 1, rf.KHz*462562.5, rf.KHz*25, 7, 2, rf.KHz*12.5),
+		channelPlan(ServiceFRS, 8, rf.KHz*467562.5, rf.KHz*25, 7, 0.5, rf.KHz*12.5),
+		channelPlan(ServiceFRS, 15, rf.KHz*462550, rf.KHz*25, 8, 2, rf.KHz*12.5),
+	)
+
+	// GMRS are the 22 simplex channels of the US General Mobile Radio
+	// Service (47 CFR 95 Subpart E), followed by the 8 repeater inputs,
+	// which are 5MHz above the repeater outputs on channels 15 through 22.
+	GMRS = join(
+		channelPlan(ServiceGMRS, 1, rf.KHz*462562.5, rf.KHz*25, 7, 5, rf.KHz*20),
+		channelPlan(ServiceGMRS, 8, rf.KHz*467562.5, rf.KHz*25, 7, 0.5, rf.KHz*12.5),
+		channelPlan(ServiceGMRS, 15, rf.KHz*462550, rf.KHz*25, 8, 50, rf.KHz*20),
+		repeaterInputs(channelPlan(ServiceGMRS, 15, rf.KHz*467550, rf.KHz*25, 8, 50, rf.KHz*20)),
+	)
+
+	// MURS are the 5 channels of the US Multi-Use Radio Service (47 CFR 95
+	// Subpart J).
+	MURS = Channels{
+		{Service: ServiceMURS, Number: 1, Frequency: rf.KHz * 151820, MaxPower: 2, Bandwidth: rf.KHz * 11.25},
+		{Service: ServiceMURS, Number: 2, Frequency: rf.KHz * 151880, MaxPower: 2, Bandwidth: rf.KHz * 11.25},
+		{Service: ServiceMURS, Number: 3, Frequency: rf.KHz * 151940, MaxPower: 2, Bandwidth: rf.KHz * 11.25},
+		{Service: ServiceMURS, Number: 4, Frequency: rf.KHz * 154570, MaxPower: 2, Bandwidth: rf.KHz * 20},
+		{Service: ServiceMURS, Number: 5, Frequency: rf.KHz * 154600, MaxPower: 2, Bandwidth: rf.KHz * 20},
+	}
+
+	// CB are the 40 channels of the 27MHz Citizens Band, shared by the US
+	// (47 CFR 95 Subpart D) and CEPT (ECC/DEC/(11)03) countries. MaxPower
+	// is the 4W carrier power limit for AM and FM; SSB is permitted 12W PEP.
+	CB = cbChannels(ServiceCB, []rf.Hz{
+		26965, 26975, 26985, 27005, 27015, 27025, 27035, 27055, 27065, 27075,
+		27085, 27105, 27115, 27125, 27135, 27155, 27165, 27175, 27185, 27205,
+		27215, 27225, 27255, 27235, 27245, 27265, 27275, 27285, 27295, 27305,
+		27315, 27325, 27335, 27345, 27355, 27365, 27375, 27385, 27395, 27405,
+	})
+
+	// UKCB are the 40 channels of the UK specific 27/81 Citizens Band
+	// allocation, which are FM only.
+	UKCB = channelPlan(ServiceUKCB, 1, rf.KHz*27601.25, rf.KHz*10, 40, 4, rf.KHz*8.5)
+
+	// PMR446 are the 16 analog channels of the European PMR446 service
+	// (ECC/DEC/(15)05), limited to 0.5W ERP.
+	PMR446 = channelPlan(ServicePMR446, 1, rf.KHz*446006.25, rf.KHz*12.5, 16, 0.5, rf.KHz*12.5)
+
+	// DPMR446 are the 32 digital 6.25kHz channels of the European PMR446
+	// service (ECC/DEC/(15)05), limited to 0.5W ERP.
+	DPMR446 = channelPlan(ServiceDPMR446, 1, rf.KHz*446003.125, rf.KHz*6.25, 32, 0.5, rf.KHz*6.25)
+
+	// UHFCB are the 80 channels of the Australian UHF Citizens Band (ACMA
+	// LIPD class licence). Channels 31-38 and 71-78 are repeater inputs
+	// for channels 1-8 and 41-48, and channels 5 and 35 are reserved for
+	// emergency use.
+	UHFCB = join(
+		channelPlan(ServiceUHFCB, 1, rf.KHz*476425, rf.KHz*25, 30, 5, rf.KHz*12.5),
+		repeaterInputs(channelPlan(ServiceUHFCB, 31, rf.KHz*477175, rf.KHz*25, 8, 5, rf.KHz*12.5)),
+		channelPlan(ServiceUHFCB, 39, rf.KHz*477375, rf.KHz*25, 2, 5, rf.KHz*12.5),
+		channelPlan(ServiceUHFCB, 41, rf.KHz*476437.5, rf.KHz*25, 30, 5, rf.KHz*12.5),
+		repeaterInputs(channelPlan(ServiceUHFCB, 71, rf.KHz*477187.5, rf.KHz*25, 8, 5, rf.KHz*12.5)),
+		channelPlan(ServiceUHFCB, 79, rf.KHz*477387.5, rf.KHz*25, 2, 5, rf.KHz*12.5),
+	)
+
+	// LPD433 are the 69 channels of the European 433MHz Low Power Device
+	// allocation, limited to 10mW ERP.
+	LPD433 = channelPlan(ServiceLPD433, 1, rf.KHz*433075, rf.KHz*25, 69, 0.01, rf.KHz*25)
+
+	// AllChannels are the channels from every Service in this package.
+	AllChannels = join(FRS, GMRS, MURS, CB, UKCB, PMR446, DPMR446, UHFCB, LPD433)
+
+	// All are the channels from every Service in this package, as
+	// rf.Allocations.
+	All = AllChannels.Allocations()
+)
+
+// repeaterInputs will mark all the provided channels as repeater inputs.
+func repeaterInputs(channels Channels) Channels {
+	ret := make(Channels, len(channels))
+	for i, c := range channels {
+		c.Repeater = true
+		ret[i] = c
+	}
+	return ret
+}
+
+// cbChannels will build the 27MHz CB channel list from a list of frequencies,
+// in kHz, ordered by channel number.
+func cbChannels(service Service, khz []rf.Hz) Channels {
+	ret := Channels{}
+	for i, freq := range khz {
+		ret = append(ret, Channel{
+			Service:   service,
+			Number:    i + 1,
+			Frequency: freq * rf.KHz,
+			MaxPower:  4,
+			Bandwidth: rf.KHz * 8,
+		})
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,228 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package repeater
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+// Repeater is a pair of frequencies -- the frequency the repeater transmits
+// on (Output), and the frequency the repeater listens on (Input) -- along
+// with the CTCSS tone or DCS code required to access it.
+type Repeater struct {
+	// Output is the frequency the repeater transmits on, and the frequency
+	// a user would listen on.
+	Output rf.Hz
+
+	// Input is the frequency the repeater listens on, and the frequency a
+	// user would transmit on.
+	Input rf.Hz
+
+	// CTCSS is the access tone, if any.
+	CTCSS CTCSS
+
+	// DCS is the access code, if any.
+	DCS DCS
+}
+
+// New will create a new Repeater given the output frequency, and the offset
+// from the output to the input frequency.
+func New(output rf.Hz, offset rf.Hz) Repeater {
+	return Repeater{Output: output, Input: output + offset}
+}
+
+// NewStandard will create a new Repeater given the output frequency, using
+// the standard offset from the provided plan, such as Region2Shifts.
+func NewStandard(plan rf.Allocations, output rf.Hz) (Repeater, error) {
+	offset, err := StandardOffset(plan, output)
+	if err != nil {
+		return Repeater{}, err
+	}
+	return New(output, offset), nil
+}
+
+// Offset will return the difference between the Input and Output frequency,
+// which will be negative if the Input is below the Output.
+func (r Repeater) Offset() rf.Hz {
+	return r.Input - r.Output
+}
+
+// Validate will ensure that the Repeater's access tone or code is one of the
+// standard values, and that only one of CTCSS and DCS is set.
+func (r Repeater) Validate() error {
+	if r.CTCSS != 0 && r.DCS.Code != 0 {
+		return fmt.Errorf("repeater: both CTCSS and DCS set")
+	}
+	if r.CTCSS != 0 && !r.CTCSS.Valid() {
+		return fmt.Errorf("repeater: non-standard CTCSS tone: %s", r.CTCSS)
+	}
+	if r.DCS.Code != 0 && !r.DCS.Valid() {
+		return fmt.Errorf("repeater: non-standard DCS code: %s", r.DCS)
+	}
+	return nil
+}
+
+// Format will return the Repeater in the usual notation, such as
+// "146.940- 100.0". If the offset is not the standard offset in the provided
+// plan, the offset is written out in MHz, such as "146.940 -1.000 100.0".
+func (r Repeater) Format(plan rf.Allocations) string {
+	var (
+		offset = r.Offset()
+		parts  = []string{formatMHz(r.Output)}
+	)
+
+	standard, err := StandardOffset(plan, r.Output)
+	switch {
+	case offset == 0:
+	case err == nil && math.Abs(float64(standard)) == math.Abs(float64(offset)):
+		if offset < 0 {
+			parts[0] += "-"
+		} else {
+			parts[0] += "+"
+		}
+	default:
+		sign := "+"
+		if offset < 0 {
+			sign = "-"
+			offset = -offset
+		}
+		parts = append(parts, sign+formatMHz(offset))
+	}
+
+	switch {
+	case r.CTCSS != 0:
+		parts = append(parts, r.CTCSS.String())
+	case r.DCS.Code != 0:
+		parts = append(parts, r.DCS.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// String will return the Repeater in the usual notation, using the Region 2
+// standard offsets. Use Format to use another region's standard offsets.
+func (r Repeater) String() string {
+	return r.Format(Region2Shifts)
+}
+
+// Parse will parse a Repeater in the usual notation, such as
+// "146.940- 100.0", "443.500+ D023N" or "146.520". A "+" or "-" after the
+// output frequency will use the magnitude of the standard offset in the
+// provided plan. An explicit offset in MHz, such as "146.940 -1.0 100.0",
+// may be given instead.
+func Parse(plan rf.Allocations, notation string) (Repeater, error) {
+	fields := strings.Fields(notation)
+	if len(fields) == 0 {
+		return Repeater{}, fmt.Errorf("repeater: empty repeater")
+	}
+
+	var (
+		output = fields[0]
+		sign   = output[len(output)-1]
+		r      = Repeater{}
+	)
+
+	switch sign {
+	case '+', '-':
+		output = output[:len(output)-1]
+	}
+
+	mhz, err := strconv.ParseFloat(output, 64)
+	if err != nil {
+		return Repeater{}, fmt.Errorf("repeater: invalid output frequency: %s", fields[0])
+	}
+	r.Output = parseMHz(mhz)
+	r.Input = r.Output
+	fields = fields[1:]
+
+	switch sign {
+	case '+', '-':
+		standard, err := StandardOffset(plan, r.Output)
+		if err != nil {
+			return Repeater{}, err
+		}
+		offset := rf.Hz(math.Abs(float64(standard)))
+		if sign == '-' {
+			offset = -offset
+		}
+		r.Input = r.Output + offset
+	default:
+		if len(fields) > 0 && (fields[0][0] == '+' || fields[0][0] == '-') {
+			mhz, err := strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				return Repeater{}, fmt.Errorf("repeater: invalid offset: %s", fields[0])
+			}
+			r.Input = r.Output + parseMHz(mhz)
+			fields = fields[1:]
+		}
+	}
+
+	switch len(fields) {
+	case 0:
+	case 1:
+		tone := fields[0]
+		if strings.HasPrefix(strings.ToUpper(tone), "D") {
+			r.DCS, err = ParseDCS(tone)
+		} else {
+			r.CTCSS, err = ParseCTCSS(tone)
+		}
+		if err != nil {
+			return Repeater{}, err
+		}
+	default:
+		return Repeater{}, fmt.Errorf("repeater: trailing data: %s", notation)
+	}
+
+	return r, r.Validate()
+}
+
+// MustParse will run the notation through Parse, and on error, panic.
+func MustParse(plan rf.Allocations, notation string) Repeater {
+	r, err := Parse(plan, notation)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// parseMHz will convert a float in MHz to rf.Hz, rounding to the nearest Hz.
+func parseMHz(mhz float64) rf.Hz {
+	return rf.Hz(math.Round(mhz * float64(rf.MHz)))
+}
+
+// formatMHz will format the frequency in MHz to three decimal places, or
+// more if required to represent the frequency exactly (such as 12.5kHz
+// channels).
+func formatMHz(freq rf.Hz) string {
+	s := strconv.FormatFloat(float64(freq/rf.MHz), 'f', -1, 64)
+	if i := strings.Index(s, "."); i < 0 {
+		s += ".000"
+	} else if pad := 3 - (len(s) - i - 1); pad > 0 {
+		s += strings.Repeat("0", pad)
+	}
+	return s
+}
+
+// vim: foldmethod=marker
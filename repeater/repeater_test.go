@@ -0,0 +1,101 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package repeater_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/repeater"
+)
+
+func TestParse(t *testing.T) {
+	r, err := repeater.Parse(repeater.Region2Shifts, "146.940- 100.0")
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*146940, r.Output)
+	assert.Equal(t, rf.KHz*146340, r.Input)
+	assert.Equal(t, repeater.CTCSS(100), r.CTCSS)
+	assert.Equal(t, "146.940- 100.0", r.String())
+
+	r, err = repeater.Parse(repeater.Region2Shifts, "443.500+ D023N")
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*448500, r.Input)
+	assert.Equal(t, uint16(023), r.DCS.Code)
+	assert.Equal(t, "443.500+ D023N", r.String())
+
+	r, err = repeater.Parse(repeater.Region2Shifts, "146.940 -1.0 67")
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*145940, r.Input)
+	assert.Equal(t, "146.940 -1.000 67.0", r.String())
+
+	r, err = repeater.Parse(repeater.Region2Shifts, "146.52")
+	assert.NoError(t, err)
+	assert.Equal(t, r.Output, r.Input)
+	assert.Equal(t, "146.520", r.String())
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, notation := range []string{
+		"", "abc", "146.940- 100.1", "146.940- D024", "146.940- 100.0 extra", "28.000+",
+	} {
+		_, err := repeater.Parse(repeater.Region2Shifts, notation)
+		assert.Error(t, err, notation)
+	}
+}
+
+func TestStandardOffset(t *testing.T) {
+	r, err := repeater.NewStandard(repeater.Region2Shifts, rf.KHz*147060)
+	assert.NoError(t, err)
+	assert.Equal(t, rf.KHz*600, r.Offset())
+
+	r, err = repeater.NewStandard(repeater.Region1Shifts, rf.KHz*145650)
+	assert.NoError(t, err)
+	assert.Equal(t, -rf.KHz*600, r.Offset())
+	assert.Equal(t, "145.650-", r.Format(repeater.Region1Shifts))
+
+	_, err = repeater.NewStandard(repeater.Region2Shifts, rf.KHz*146520)
+	assert.Error(t, err)
+
+	offset, ok := repeater.OffsetOf(repeater.Region2Shifts.ContainingFrequency(rf.KHz * 147060).First())
+	assert.True(t, ok)
+	assert.Equal(t, rf.KHz*600, offset)
+}
+
+func TestTones(t *testing.T) {
+	assert.Equal(t, 50, len(repeater.CTCSSTones))
+	assert.Equal(t, 104, len(repeater.DCSCodes))
+
+	d, err := repeater.ParseDCS("d754i")
+	assert.NoError(t, err)
+	assert.True(t, d.Inverted)
+	assert.Equal(t, "D754I", d.String())
+
+	c, err := repeater.ParseCTCSS("88.5")
+	assert.NoError(t, err)
+	assert.Equal(t, "88.5", c.String())
+
+	r := repeater.Repeater{Output: rf.KHz * 146940, CTCSS: 100, DCS: d}
+	assert.Error(t, r.Validate())
+}
+
+// vim: foldmethod=marker
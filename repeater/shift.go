@@ -0,0 +1,96 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package repeater
+
+import (
+	"fmt"
+
+	"hz.tools/rf"
+)
+
+// ErrNoStandardOffset will be returned if there's no standard repeater
+// offset for the provided output frequency.
+var ErrNoStandardOffset = fmt.Errorf("repeater: no standard offset for frequency")
+
+// shift will create an Allocation covering the provided output frequencies,
+// with the offset to the input frequency set as Metadata.
+func shift(name string, low, high rf.Hz, offset rf.Hz) rf.Allocation {
+	return rf.Allocation{
+		Name:     name,
+		Range:    rf.Range{low, high},
+		Metadata: offset,
+	}
+}
+
+// OffsetOf will return the offset from the output frequency to the input
+// frequency of an rf.Allocation from a plan such as Region2Shifts, and false
+// if the rf.Allocation doesn't have one.
+func OffsetOf(a rf.Allocation) (rf.Hz, bool) {
+	offset, ok := a.Metadata.(rf.Hz)
+	return offset, ok
+}
+
+var (
+	// Region2Shifts are the standard repeater offsets used in ITU Region 2,
+	// as per the ARRL band plan. Each Allocation covers a range of output
+	// frequencies, and the Metadata is the rf.Hz offset from the output
+	// frequency to the input frequency.
+	Region2Shifts = rf.Allocations{
+		shift("10m", rf.KHz*29610, rf.KHz*29700, -rf.KHz*100),
+		shift("6m", rf.KHz*52500, rf.KHz*54000, -rf.KHz*500),
+		shift("2m", rf.KHz*145100, rf.KHz*145500, -rf.KHz*600),
+		shift("2m", rf.KHz*146000, rf.KHz*146400, rf.KHz*600),
+		shift("2m", rf.KHz*146600, rf.KHz*146990, -rf.KHz*600),
+		shift("2m", rf.KHz*147000, rf.KHz*147400, rf.KHz*600),
+		shift("2m", rf.KHz*147600, rf.KHz*147990, -rf.KHz*600),
+		shift("1.25m", rf.KHz*223850, rf.KHz*225000, -rf.KHz*1600),
+		shift("70cm", rf.KHz*442000, rf.KHz*445000, rf.MHz*5),
+		shift("70cm", rf.KHz*447000, rf.KHz*450000, -rf.MHz*5),
+		shift("33cm", rf.KHz*927000, rf.KHz*928000, -rf.MHz*25),
+		shift("23cm", rf.KHz*1282000, rf.KHz*1288000, -rf.MHz*12),
+	}
+
+	// Region1Shifts are the standard repeater offsets used in ITU Region 1,
+	// as per the IARU Region 1 band plans. Each Allocation covers a range
+	// of output frequencies, and the Metadata is the rf.Hz offset from the
+	// output frequency to the input frequency.
+	Region1Shifts = rf.Allocations{
+		shift("10m", rf.KHz*29620, rf.KHz*29680, -rf.KHz*100),
+		shift("6m", rf.KHz*51810, rf.KHz*51990, -rf.KHz*600),
+		shift("2m", rf.KHz*145575, rf.KHz*145800, -rf.KHz*600),
+		shift("70cm", rf.KHz*438650, rf.KHz*439425, -rf.KHz*7600),
+		shift("23cm", rf.KHz*1297000, rf.KHz*1298000, -rf.MHz*6),
+	}
+)
+
+// StandardOffset will return the standard repeater offset for a repeater
+// whose output is on the provided frequency, using the offsets from the
+// provided plan, such as Region2Shifts.
+func StandardOffset(plan rf.Allocations, output rf.Hz) (rf.Hz, error) {
+	for _, allocation := range plan.ContainingFrequency(output) {
+		if offset, ok := OffsetOf(allocation); ok {
+			return offset, nil
+		}
+	}
+	return 0, ErrNoStandardOffset
+}
+
+// vim: foldmethod=marker
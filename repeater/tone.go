@@ -0,0 +1,153 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package repeater
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+// CTCSS is a Continuous Tone-Coded Squelch System tone. The zero value
+// indicates that no tone is in use.
+type CTCSS rf.Hz
+
+// String will return the tone in the usual notation, in Hz to one decimal
+// place, such as "100.0".
+func (c CTCSS) String() string {
+	return strconv.FormatFloat(float64(c), 'f', 1, 64)
+}
+
+// Valid will check that the tone is one of the standard tones listed in
+// CTCSSTones.
+func (c CTCSS) Valid() bool {
+	for _, tone := range CTCSSTones {
+		if tone == c {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCTCSS will parse a tone in Hz, such as "100.0" or "67", and ensure
+// that it is a standard CTCSS tone.
+func ParseCTCSS(tone string) (CTCSS, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(tone), 64)
+	if err != nil {
+		return 0, fmt.Errorf("repeater: invalid CTCSS tone: %s", tone)
+	}
+	// Round to the tenth of a Hz, since that's the precision of the table.
+	c := CTCSS(math.Round(f*10) / 10)
+	if !c.Valid() {
+		return 0, fmt.Errorf("repeater: non-standard CTCSS tone: %s", tone)
+	}
+	return c, nil
+}
+
+// CTCSSTones are the 50 standard CTCSS tones, in ascending order.
+var CTCSSTones = []CTCSS{
+	67.0, 69.3, 71.9, 74.4, 77.0, 79.7, 82.5, 85.4, 88.5, 91.5,
+	94.8, 97.4, 100.0, 103.5, 107.2, 110.9, 114.8, 118.8, 123.0, 127.3,
+	131.8, 136.5, 141.3, 146.2, 151.4, 156.7, 159.8, 162.2, 165.5, 167.9,
+	171.3, 173.8, 177.3, 179.9, 183.5, 186.2, 189.9, 192.8, 196.6, 199.5,
+	203.5, 206.5, 210.7, 218.1, 225.7, 229.1, 233.6, 241.8, 250.3, 254.1,
+}
+
+// DCS is a Digital-Coded Squelch code. The zero value indicates that no code
+// is in use.
+type DCS struct {
+	// Code is the DCS code. Codes are conventionally written in octal, so
+	// "023" is stored as 023 (or 19 decimal).
+	Code uint16
+
+	// Inverted is set if the code is sent with inverted polarity.
+	Inverted bool
+}
+
+// String will return the code in the usual notation, such as "D023N" or
+// "D754I".
+func (d DCS) String() string {
+	polarity := "N"
+	if d.Inverted {
+		polarity = "I"
+	}
+	return fmt.Sprintf("D%03o%s", d.Code, polarity)
+}
+
+// Valid will check that the code is one of the standard codes listed in
+// DCSCodes.
+func (d DCS) Valid() bool {
+	for _, code := range DCSCodes {
+		if code == d.Code {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDCS will parse a DCS code such as "D023N", "D754I" or "023", and
+// ensure that it is a standard DCS code. If no polarity is given, normal
+// polarity is assumed.
+func ParseDCS(code string) (DCS, error) {
+	var (
+		d = DCS{}
+		s = strings.ToUpper(strings.TrimSpace(code))
+	)
+
+	s = strings.TrimPrefix(s, "D")
+	switch {
+	case strings.HasSuffix(s, "N"):
+		s = strings.TrimSuffix(s, "N")
+	case strings.HasSuffix(s, "I"):
+		s = strings.TrimSuffix(s, "I")
+		d.Inverted = true
+	}
+
+	c, err := strconv.ParseUint(s, 8, 16)
+	if err != nil {
+		return DCS{}, fmt.Errorf("repeater: invalid DCS code: %s", code)
+	}
+	d.Code = uint16(c)
+	if !d.Valid() {
+		return DCS{}, fmt.Errorf("repeater: non-standard DCS code: %s", code)
+	}
+	return d, nil
+}
+
+// DCSCodes are the 104 standard DCS codes, in ascending order.
+var DCSCodes = []uint16{
+	0023, 0025, 0026, 0031, 0032, 0036, 0043, 0047, 0051, 0053,
+	0054, 0065, 0071, 0072, 0073, 0074, 0114, 0115, 0116, 0122,
+	0125, 0131, 0132, 0134, 0143, 0145, 0152, 0155, 0156, 0162,
+	0165, 0172, 0174, 0205, 0212, 0223, 0225, 0226, 0243, 0244,
+	0245, 0246, 0251, 0252, 0255, 0261, 0263, 0265, 0266, 0271,
+	0274, 0306, 0311, 0315, 0325, 0331, 0332, 0343, 0346, 0351,
+	0356, 0364, 0365, 0371, 0411, 0412, 0413, 0423, 0431, 0432,
+	0445, 0446, 0452, 0454, 0455, 0462, 0464, 0465, 0466, 0503,
+	0506, 0516, 0523, 0526, 0532, 0546, 0565, 0606, 0612, 0624,
+	0627, 0631, 0632, 0654, 0662, 0664, 0703, 0712, 0723, 0731,
+	0732, 0734, 0743, 0754,
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,212 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package satellite
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidOMM will be returned when an Orbit Mean-Elements Message is
+// not well formed, or is missing a required field.
+var ErrInvalidOMM = fmt.Errorf("satellite: invalid orbit mean-elements message")
+
+// ommEpochLayouts are the accepted formats of the OMM EPOCH field.
+var ommEpochLayouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04:05",
+}
+
+// parseOMM will convert the OMM keywords and values into Elements.
+func parseOMM(fields map[string]string) (Elements, error) {
+	var (
+		e    = Elements{}
+		errs []error
+	)
+	required := func(key string) string {
+		v, ok := fields[key]
+		if !ok {
+			errs = append(errs, ErrInvalidOMM)
+		}
+		return v
+	}
+	atof := func(v string) float64 {
+		if v == "" {
+			return 0
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		errs = append(errs, err)
+		return f
+	}
+	atoi := func(v string) int {
+		if v == "" {
+			return 0
+		}
+		i, err := strconv.Atoi(v)
+		errs = append(errs, err)
+		return i
+	}
+
+	if theory, ok := fields["MEAN_ELEMENT_THEORY"]; ok && theory != "SGP4" {
+		return Elements{}, ErrInvalidOMM
+	}
+
+	e.Name = fields["OBJECT_NAME"]
+	e.CatalogNumber = atoi(fields["NORAD_CAT_ID"])
+	e.Classification = fields["CLASSIFICATION_TYPE"]
+	if id := fields["OBJECT_ID"]; len(id) > 5 && id[4] == '-' {
+		// "1998-067A" is "98067A" in a TLE.
+		e.InternationalDesignator = id[2:4] + id[5:]
+	}
+	e.MeanMotionDot = atof(fields["MEAN_MOTION_DOT"])
+	e.MeanMotionDDot = atof(fields["MEAN_MOTION_DDOT"])
+	e.BStar = atof(fields["BSTAR"])
+	e.ElementSetNumber = atoi(fields["ELEMENT_SET_NO"])
+	e.Inclination = atof(required("INCLINATION"))
+	e.RightAscension = atof(required("RA_OF_ASC_NODE"))
+	e.Eccentricity = atof(required("ECCENTRICITY"))
+	e.ArgumentOfPerigee = atof(required("ARG_OF_PERICENTER"))
+	e.MeanAnomaly = atof(required("MEAN_ANOMALY"))
+	e.MeanMotion = atof(required("MEAN_MOTION"))
+	e.RevolutionNumber = atoi(fields["REV_AT_EPOCH"])
+
+	epoch := required("EPOCH")
+	for _, layout := range ommEpochLayouts {
+		t, err := time.Parse(layout, epoch)
+		if err == nil {
+			e.Epoch = t.UTC()
+			break
+		}
+	}
+	if e.Epoch.IsZero() {
+		errs = append(errs, ErrInvalidOMM)
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return Elements{}, ErrInvalidOMM
+		}
+	}
+	return e, nil
+}
+
+// readOMMJSON will read OMM fields from JSON, either a single object or a
+// list of objects. Values may be JSON strings or numbers.
+func readOMMJSON(data []byte) ([]map[string]string, error) {
+	var raw []map[string]interface{}
+	if bytes.HasPrefix(data, []byte("{")) {
+		var single map[string]interface{}
+		if err := json.Unmarshal(data, &single); err != nil {
+			return nil, err
+		}
+		raw = append(raw, single)
+	} else if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	ret := []map[string]string{}
+	for _, obj := range raw {
+		fields := map[string]string{}
+		for key, value := range obj {
+			switch v := value.(type) {
+			case string:
+				fields[key] = v
+			case float64:
+				fields[key] = strconv.FormatFloat(v, 'g', -1, 64)
+			}
+		}
+		ret = append(ret, fields)
+	}
+	return ret, nil
+}
+
+// readOMMKVN will read OMM fields from the "KEY = VALUE" text format. A new
+// message starts at every CCSDS_OMM_VERS line.
+func readOMMKVN(data []byte) ([]map[string]string, error) {
+	var (
+		ret     = []map[string]string{}
+		fields  map[string]string
+		scanner = bufio.NewScanner(bytes.NewReader(data))
+	)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "COMMENT") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, ErrInvalidOMM
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		// Drop any trailing units, such as "15.49 [rev/day]".
+		if i := strings.Index(value, " ["); i >= 0 {
+			value = value[:i]
+		}
+		if key == "CCSDS_OMM_VERS" || fields == nil {
+			fields = map[string]string{}
+			ret = append(ret, fields)
+		}
+		fields[key] = value
+	}
+	return ret, scanner.Err()
+}
+
+// ReadOMMs will read every Orbit Mean-Elements Message from the reader, in
+// either the JSON format (as served by CelesTrak or Space-Track) or the
+// KVN ("KEY = VALUE") text format.
+func ReadOMMs(r io.Reader) ([]Elements, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSpace(data)
+
+	var messages []map[string]string
+	if bytes.HasPrefix(data, []byte("{")) || bytes.HasPrefix(data, []byte("[")) {
+		messages, err = readOMMJSON(data)
+	} else {
+		messages, err = readOMMKVN(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := []Elements{}
+	for _, fields := range messages {
+		e, err := parseOMM(fields)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, e)
+	}
+	return ret, nil
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,249 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package satellite
+
+import (
+	"fmt"
+	"time"
+
+	"hz.tools/rf"
+	"hz.tools/rf/doppler"
+)
+
+// ErrInvalidStep will be returned when a Schedule is requested with a step
+// that isn't positive.
+var ErrInvalidStep = fmt.Errorf("satellite: schedule step must be positive")
+
+const (
+	// passStep is the interval used to search for passes. Passes that are
+	// shorter than this may be missed.
+	passStep = 20 * time.Second
+
+	// passPrecision is how precisely AOS, TCA and LOS are found.
+	passPrecision = 100 * time.Millisecond
+)
+
+// Pass is a single pass of a satellite over a Station, above some minimum
+// elevation.
+type Pass struct {
+	// AOS is the Acquisition of Signal, when the satellite rises above the
+	// minimum elevation.
+	AOS time.Time
+
+	// TCA is the Time of Closest Approach, when the satellite is at its
+	// highest elevation.
+	TCA time.Time
+
+	// LOS is the Loss of Signal, when the satellite sets below the minimum
+	// elevation.
+	LOS time.Time
+
+	// MaxElevation is the elevation at TCA, in degrees.
+	MaxElevation float64
+}
+
+// Duration will return the time between AOS and LOS.
+func (p Pass) Duration() time.Duration {
+	return p.LOS.Sub(p.AOS)
+}
+
+// elevation will return the elevation of the satellite above the Station
+// at the provided time.
+func elevation(sat *SGP4, station Station, t time.Time) (float64, error) {
+	state, err := sat.Propagate(t)
+	if err != nil {
+		return 0, err
+	}
+	return station.Look(t, state).Elevation, nil
+}
+
+// crossing will bisect the time between a and b, where the satellite is
+// above minElevation at exactly one end, to find when it crosses.
+func crossing(sat *SGP4, station Station, a, b time.Time, minElevation float64) (time.Time, error) {
+	aboveA, err := elevation(sat, station, a)
+	if err != nil {
+		return time.Time{}, err
+	}
+	rising := aboveA < minElevation
+	for b.Sub(a) > passPrecision {
+		mid := a.Add(b.Sub(a) / 2)
+		el, err := elevation(sat, station, mid)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if (el < minElevation) == rising {
+			a = mid
+		} else {
+			b = mid
+		}
+	}
+	return a.Add(b.Sub(a) / 2), nil
+}
+
+// culmination will find the time of the highest elevation between a and b
+// using a ternary search, assuming a single maximum.
+func culmination(sat *SGP4, station Station, a, b time.Time) (time.Time, float64, error) {
+	for b.Sub(a) > passPrecision {
+		third := b.Sub(a) / 3
+		m1, m2 := a.Add(third), b.Add(-third)
+		e1, err := elevation(sat, station, m1)
+		if err != nil {
+			return time.Time{}, 0, err
+		}
+		e2, err := elevation(sat, station, m2)
+		if err != nil {
+			return time.Time{}, 0, err
+		}
+		if e1 < e2 {
+			a = m1
+		} else {
+			b = m2
+		}
+	}
+	tca := a.Add(b.Sub(a) / 2)
+	el, err := elevation(sat, station, tca)
+	return tca, el, err
+}
+
+// Passes will return every Pass of the satellite over the Station, above
+// minElevation degrees, between start and end. A pass in progress at start
+// or end will be cut off at those times.
+func Passes(sat *SGP4, station Station, start, end time.Time, minElevation float64) ([]Pass, error) {
+	var (
+		ret     = []Pass{}
+		current *Pass
+		peak    time.Time
+		peakEl  float64
+	)
+
+	finish := func(los time.Time) error {
+		a, b := peak.Add(-passStep), peak.Add(passStep)
+		if a.Before(current.AOS) {
+			a = current.AOS
+		}
+		if b.After(los) {
+			b = los
+		}
+		tca, el, err := culmination(sat, station, a, b)
+		if err != nil {
+			return err
+		}
+		if el < peakEl {
+			tca, el = peak, peakEl
+		}
+		current.TCA, current.MaxElevation, current.LOS = tca, el, los
+		ret = append(ret, *current)
+		current = nil
+		return nil
+	}
+
+	prev := start
+	for t := start; !t.After(end); t = t.Add(passStep) {
+		el, err := elevation(sat, station, t)
+		if err != nil {
+			return nil, err
+		}
+		up := el >= minElevation
+
+		switch {
+		case up && current == nil:
+			aos := t
+			if t != start {
+				if aos, err = crossing(sat, station, prev, t, minElevation); err != nil {
+					return nil, err
+				}
+			}
+			current = &Pass{AOS: aos}
+			peak, peakEl = t, el
+		case up && el > peakEl:
+			peak, peakEl = t, el
+		case !up && current != nil:
+			los, err := crossing(sat, station, prev, t, minElevation)
+			if err != nil {
+				return nil, err
+			}
+			if err := finish(los); err != nil {
+				return nil, err
+			}
+		}
+		prev = t
+	}
+
+	if current != nil {
+		if err := finish(end); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// Tuning is the Doppler corrected radio tuning at a point in time during a
+// Pass.
+type Tuning struct {
+	// Time this Tuning is valid at.
+	Time time.Time
+
+	// Look is the direction of the satellite from the Station.
+	Look Look
+
+	// Downlink is the frequency the satellite's downlink will be received
+	// on at the Station.
+	Downlink rf.Hz
+
+	// Uplink is the frequency the Station must transmit on for the
+	// satellite to receive the uplink on its nominal frequency.
+	Uplink rf.Hz
+}
+
+// Schedule will return the Doppler corrected Tuning for the satellite's
+// nominal downlink and uplink frequencies, every step from the AOS to the
+// LOS of the Pass, inclusive. A zero frequency will stay zero.
+func Schedule(sat *SGP4, station Station, pass Pass, downlink, uplink rf.Hz, step time.Duration) ([]Tuning, error) {
+	if step <= 0 {
+		return nil, ErrInvalidStep
+	}
+	ret := []Tuning{}
+	for t := pass.AOS; ; t = t.Add(step) {
+		if t.After(pass.LOS) {
+			t = pass.LOS
+		}
+		state, err := sat.Propagate(t)
+		if err != nil {
+			return nil, err
+		}
+		ground := station.State(t)
+
+		tuning := Tuning{Time: t, Look: station.Look(t, state)}
+		tuning.Downlink, _ = doppler.RelativisticBetween(downlink, state, ground)
+		if uplink != 0 {
+			observed, _ := doppler.RelativisticBetween(uplink, ground, state)
+			tuning.Uplink = uplink * uplink / observed
+		}
+		ret = append(ret, tuning)
+
+		if !t.Before(pass.LOS) {
+			break
+		}
+	}
+	return ret, nil
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,78 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package satellite_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/satellite"
+)
+
+func TestPassSchedule(t *testing.T) {
+	elements, err := satellite.ReadTLEs(strings.NewReader(iss))
+	assert.NoError(t, err)
+	sat, err := satellite.NewSGP4(elements[0])
+	assert.NoError(t, err)
+
+	station := satellite.Station{Latitude: 42.36, Longitude: -71.06, Altitude: 10}
+	start := elements[0].Epoch
+	passes, err := satellite.Passes(sat, station, start, start.Add(24*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.True(t, len(passes) > 0)
+
+	for _, pass := range passes {
+		assert.True(t, pass.AOS.Before(pass.TCA))
+		assert.True(t, pass.TCA.Before(pass.LOS))
+		assert.True(t, pass.MaxElevation >= 10)
+		assert.True(t, pass.Duration() < 15*time.Minute)
+
+		state, err := sat.Propagate(pass.AOS)
+		assert.NoError(t, err)
+		assert.InDelta(t, 10, station.Look(pass.AOS, state).Elevation, 0.01)
+	}
+
+	pass := passes[0]
+	downlink := rf.KHz * 145800
+	uplink := rf.KHz * 437800
+	schedule, err := satellite.Schedule(sat, station, pass, downlink, uplink, 10*time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, pass.AOS, schedule[0].Time)
+	assert.Equal(t, pass.LOS, schedule[len(schedule)-1].Time)
+
+	// Approaching at AOS, receding at LOS.
+	first, last := schedule[0], schedule[len(schedule)-1]
+	assert.True(t, first.Downlink > downlink)
+	assert.True(t, first.Uplink < uplink)
+	assert.True(t, last.Downlink < downlink)
+	assert.True(t, last.Uplink > uplink)
+	assert.InDelta(t, float64(downlink), float64(downlink.Doppler(first.Look.RangeRate)), 4000)
+	assert.InDelta(t, float64(first.Downlink), float64(downlink.Doppler(first.Look.RangeRate)), 1)
+
+	_, err = satellite.Schedule(sat, station, pass, downlink, uplink, 0)
+	assert.Equal(t, satellite.ErrInvalidStep, err)
+}
+
+// vim: foldmethod=marker
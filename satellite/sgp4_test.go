@@ -0,0 +1,127 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package satellite_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf/satellite"
+)
+
+const iss = `ISS (ZARYA)
+1 25544U 98067A   08264.51782528 -.00002182  00000-0 -11606-4 0  2927
+2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.72125391563537
+`
+
+func TestParseTLE(t *testing.T) {
+	elements, err := satellite.ReadTLEs(strings.NewReader(iss))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(elements))
+
+	e := elements[0]
+	assert.Equal(t, "ISS (ZARYA)", e.Name)
+	assert.Equal(t, 25544, e.CatalogNumber)
+	assert.Equal(t, "98067A", e.InternationalDesignator)
+	assert.Equal(t, 2008, e.Epoch.Year())
+	assert.Equal(t, 264, e.Epoch.YearDay())
+	assert.InDelta(t, -0.11606e-4, e.BStar, 1e-12)
+	assert.InDelta(t, -0.00002182, e.MeanMotionDot, 1e-12)
+	assert.InDelta(t, 0.0006703, e.Eccentricity, 1e-12)
+	assert.InDelta(t, 15.72125391, e.MeanMotion, 1e-9)
+	assert.Equal(t, 56353, e.RevolutionNumber)
+
+	_, err = satellite.ReadTLEs(strings.NewReader(strings.Replace(iss, "2927", "2928", 1)))
+	assert.Equal(t, satellite.ErrChecksum, err)
+}
+
+func TestReadOMM(t *testing.T) {
+	json := `[{"OBJECT_NAME":"ISS (ZARYA)","OBJECT_ID":"1998-067A",
+"EPOCH":"2008-09-20T12:25:40.104192","MEAN_MOTION":15.72125391,
+"ECCENTRICITY":0.0006703,"INCLINATION":51.6416,"RA_OF_ASC_NODE":247.4627,
+"ARG_OF_PERICENTER":130.536,"MEAN_ANOMALY":325.0288,"NORAD_CAT_ID":25544,
+"BSTAR":"-1.1606e-05","REV_AT_EPOCH":56353}]`
+	elements, err := satellite.ReadOMMs(strings.NewReader(json))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(elements))
+	assert.Equal(t, "98067A", elements[0].InternationalDesignator)
+	assert.InDelta(t, -1.1606e-05, elements[0].BStar, 1e-12)
+
+	tle, err := satellite.ReadTLEs(strings.NewReader(iss))
+	assert.NoError(t, err)
+	assert.InDelta(t, 0, elements[0].Epoch.Sub(tle[0].Epoch).Seconds(), 0.001)
+
+	kvn := `CCSDS_OMM_VERS = 2.0
+OBJECT_NAME = ISS (ZARYA)
+MEAN_ELEMENT_THEORY = SGP4
+EPOCH = 2008-09-20T12:25:40.104192
+MEAN_MOTION = 15.72125391 [rev/day]
+ECCENTRICITY = 0.0006703
+INCLINATION = 51.6416 [deg]
+RA_OF_ASC_NODE = 247.4627 [deg]
+ARG_OF_PERICENTER = 130.5360 [deg]
+MEAN_ANOMALY = 325.0288 [deg]
+`
+	elements, err = satellite.ReadOMMs(strings.NewReader(kvn))
+	assert.NoError(t, err)
+	assert.Equal(t, "ISS (ZARYA)", elements[0].Name)
+	assert.Equal(t, 15.72125391, elements[0].MeanMotion)
+
+	_, err = satellite.ReadOMMs(strings.NewReader("OBJECT_NAME = FOO\n"))
+	assert.Equal(t, satellite.ErrInvalidOMM, err)
+}
+
+func TestSGP4(t *testing.T) {
+	// Test case from Vallado et al, "Revisiting Spacetrack Report #3".
+	e, err := satellite.ParseTLE("",
+		"1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753",
+		"2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667")
+	assert.NoError(t, err)
+	sat, err := satellite.NewSGP4(e)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		minutes  float64
+		position [3]float64
+		velocity [3]float64
+	}{
+		{0, [3]float64{7022.46529266, -1400.08296755, 0.03995155}, [3]float64{1.893841015, 6.405893759, 4.534807250}},
+		{360, [3]float64{-7154.03120202, -3783.17682504, -3536.19412294}, [3]float64{4.741887409, -4.151817765, -2.093935425}},
+		{4320, [3]float64{-9060.47373569, 4658.70952502, 813.68673153}, [3]float64{-2.232832783, -4.110453490, -3.157345433}},
+	} {
+		state, err := sat.Propagate(e.Epoch.Add(time.Duration(tc.minutes * float64(time.Minute))))
+		assert.NoError(t, err)
+		for i := range tc.position {
+			assert.InDelta(t, tc.position[i]*1000, state.Position[i], 0.01)
+			assert.InDelta(t, tc.velocity[i]*1000, state.Velocity[i], 0.01)
+		}
+	}
+}
+
+func TestSGP4DeepSpace(t *testing.T) {
+	_, err := satellite.NewSGP4(satellite.Elements{MeanMotion: 1.00271, Eccentricity: 0.0001})
+	assert.Equal(t, satellite.ErrDeepSpace, err)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,140 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package satellite
+
+import (
+	"math"
+	"time"
+
+	"hz.tools/rf/doppler"
+)
+
+// WGS 84 ellipsoid, used for the location of a Station.
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+
+	// earthRotation is the rotation rate of the Earth, in radians per
+	// second.
+	earthRotation = 7.292115146706979e-5
+)
+
+// julianDate will return the Julian Date of the time.
+func julianDate(t time.Time) float64 {
+	return float64(t.UnixNano())/float64(24*time.Hour) + 2440587.5
+}
+
+// gmst will return the Greenwich Mean Sidereal Time, in radians, using the
+// IAU 1982 model that SGP4's TEME frame is defined with.
+func gmst(t time.Time) float64 {
+	tut1 := (julianDate(t) - 2451545) / 36525
+	temp := -6.2e-6*tut1*tut1*tut1 + 0.093104*tut1*tut1 +
+		(876600*3600+8640184.812866)*tut1 + 67310.54841
+	temp = math.Mod(temp*deg2rad/240, twoPi)
+	if temp < 0 {
+		temp += twoPi
+	}
+	return temp
+}
+
+// Station is a ground station on the surface of the Earth.
+type Station struct {
+	// Latitude is the geodetic latitude, in degrees north.
+	Latitude float64
+
+	// Longitude in degrees east.
+	Longitude float64
+
+	// Altitude above the WGS 84 ellipsoid, in meters.
+	Altitude float64
+}
+
+// State will return the State of the Station at the provided time, in the
+// same TEME frame as SGP4.Propagate.
+func (s Station) State(t time.Time) doppler.State {
+	lat := s.Latitude * deg2rad
+	lon := s.Longitude * deg2rad
+
+	e2 := wgs84F * (2 - wgs84F)
+	n := wgs84A / math.Sqrt(1-e2*math.Sin(lat)*math.Sin(lat))
+	rxy := (n + s.Altitude) * math.Cos(lat)
+	z := (n*(1-e2) + s.Altitude) * math.Sin(lat)
+
+	theta := gmst(t) + lon
+	x, y := rxy*math.Cos(theta), rxy*math.Sin(theta)
+	w := earthRotation
+	return doppler.State{
+		Position:     doppler.Vector{x, y, z},
+		Velocity:     doppler.Vector{-w * y, w * x, 0},
+		Acceleration: doppler.Vector{-w * w * x, -w * w * y, 0},
+	}
+}
+
+// Look is the direction and distance from a Station to a satellite.
+type Look struct {
+	// Azimuth in degrees clockwise from true north.
+	Azimuth float64
+
+	// Elevation in degrees above the horizon.
+	Elevation float64
+
+	// Range is the distance to the satellite, in meters.
+	Range float64
+
+	// RangeRate is the rate of change of the Range, in meters per second.
+	// It's positive when the satellite is moving away.
+	RangeRate float64
+}
+
+// Look will return the Look angles from the Station to the satellite at the
+// provided State and time.
+func (s Station) Look(t time.Time, satellite doppler.State) Look {
+	station := s.State(t)
+	var rho, rhoDot doppler.Vector
+	for i := range rho {
+		rho[i] = satellite.Position[i] - station.Position[i]
+		rhoDot[i] = satellite.Velocity[i] - station.Velocity[i]
+	}
+	r := math.Sqrt(rho[0]*rho[0] + rho[1]*rho[1] + rho[2]*rho[2])
+
+	lat := s.Latitude * deg2rad
+	theta := gmst(t) + s.Longitude*deg2rad
+	sinLat, cosLat := math.Sin(lat), math.Cos(lat)
+	sinTheta, cosTheta := math.Sin(theta), math.Cos(theta)
+
+	// Rotate into the topocentric South, East, Zenith frame.
+	south := sinLat*cosTheta*rho[0] + sinLat*sinTheta*rho[1] - cosLat*rho[2]
+	east := -sinTheta*rho[0] + cosTheta*rho[1]
+	zenith := cosLat*cosTheta*rho[0] + cosLat*sinTheta*rho[1] + sinLat*rho[2]
+
+	az := math.Atan2(east, -south) / deg2rad
+	if az < 0 {
+		az += 360
+	}
+	return Look{
+		Azimuth:   az,
+		Elevation: math.Asin(zenith/r) / deg2rad,
+		Range:     r,
+		RangeRate: (rho[0]*rhoDot[0] + rho[1]*rhoDot[1] + rho[2]*rhoDot[2]) / r,
+	}
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,254 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package satellite
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidTLE will be returned when a Two-Line Element set is not
+	// well formed.
+	ErrInvalidTLE = fmt.Errorf("satellite: invalid two-line element set")
+
+	// ErrChecksum will be returned when a line of a Two-Line Element set
+	// does not match its checksum.
+	ErrChecksum = fmt.Errorf("satellite: two-line element set checksum mismatch")
+)
+
+// Elements are the mean orbital elements of a satellite at an Epoch, as
+// found in a TLE or OMM, for use with SGP4.
+type Elements struct {
+	// Name of the satellite, such as "ISS (ZARYA)", if known.
+	Name string
+
+	// CatalogNumber is the NORAD catalog number of the satellite.
+	CatalogNumber int
+
+	// Classification is "U" for unclassified elements.
+	Classification string
+
+	// InternationalDesignator is the COSPAR ID, in TLE form, such as
+	// "98067A".
+	InternationalDesignator string
+
+	// Epoch is the time the Elements are valid at.
+	Epoch time.Time
+
+	// MeanMotionDot is the first derivative of the mean motion divided by
+	// two, in revolutions per day squared. This is not used by SGP4.
+	MeanMotionDot float64
+
+	// MeanMotionDDot is the second derivative of the mean motion divided by
+	// six, in revolutions per day cubed. This is not used by SGP4.
+	MeanMotionDDot float64
+
+	// BStar is the SGP4 drag term, in inverse Earth radii.
+	BStar float64
+
+	// ElementSetNumber is incremented as new elements are published.
+	ElementSetNumber int
+
+	// Inclination, in degrees.
+	Inclination float64
+
+	// RightAscension of the ascending node, in degrees.
+	RightAscension float64
+
+	// Eccentricity of the orbit.
+	Eccentricity float64
+
+	// ArgumentOfPerigee, in degrees.
+	ArgumentOfPerigee float64
+
+	// MeanAnomaly, in degrees.
+	MeanAnomaly float64
+
+	// MeanMotion, in revolutions per day.
+	MeanMotion float64
+
+	// RevolutionNumber is the number of orbits at the Epoch.
+	RevolutionNumber int
+}
+
+// tleChecksum will compute the modulo 10 checksum of the first 68
+// characters of a TLE line, where every digit counts as its value and
+// every '-' as 1.
+func tleChecksum(line string) int {
+	sum := 0
+	for _, c := range line[:68] {
+		switch {
+		case c >= '0' && c <= '9':
+			sum += int(c - '0')
+		case c == '-':
+			sum++
+		}
+	}
+	return sum % 10
+}
+
+// tleField will return the trimmed text between the 1-indexed columns
+// start and end, inclusive, as they're given in the TLE specification.
+func tleField(line string, start, end int) string {
+	return strings.TrimSpace(line[start-1 : end])
+}
+
+// tleExponent will parse a TLE field with an assumed leading decimal point
+// and trailing exponent, such as " 28098-4" for 0.28098e-4.
+func tleExponent(field string) (float64, error) {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return 0, nil
+	}
+	sign := ""
+	if field[0] == '-' || field[0] == '+' {
+		sign, field = field[:1], field[1:]
+	}
+	if len(field) < 2 {
+		return 0, ErrInvalidTLE
+	}
+	i := strings.LastIndexAny(field, "+-")
+	if i <= 0 {
+		return 0, ErrInvalidTLE
+	}
+	return strconv.ParseFloat(sign+"0."+field[:i]+"e"+field[i:], 64)
+}
+
+// tleEpoch will convert a two digit year and fractional day of the year to a
+// time.Time, in UTC.
+func tleEpoch(year int, day float64) time.Time {
+	if year < 57 {
+		year += 2000
+	} else {
+		year += 1900
+	}
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return start.Add(time.Duration((day - 1) * float64(24*time.Hour)))
+}
+
+// ParseTLE will parse the two lines of a Two-Line Element set. The name is
+// optional, and is usually the "line 0" that precedes the element set.
+func ParseTLE(name, line1, line2 string) (Elements, error) {
+	line1 = strings.TrimRight(line1, " \r\n")
+	line2 = strings.TrimRight(line2, " \r\n")
+	if len(line1) != 69 || len(line2) != 69 ||
+		line1[0] != '1' || line2[0] != '2' {
+		return Elements{}, ErrInvalidTLE
+	}
+	for _, line := range []string{line1, line2} {
+		if int(line[68]-'0') != tleChecksum(line) {
+			return Elements{}, ErrChecksum
+		}
+	}
+
+	var (
+		e    = Elements{Name: strings.TrimSpace(name)}
+		errs []error
+	)
+	atoi := func(field string) int {
+		v, err := strconv.Atoi(field)
+		errs = append(errs, err)
+		return v
+	}
+	atof := func(field string) float64 {
+		v, err := strconv.ParseFloat(field, 64)
+		errs = append(errs, err)
+		return v
+	}
+	atoe := func(field string) float64 {
+		v, err := tleExponent(field)
+		errs = append(errs, err)
+		return v
+	}
+
+	e.CatalogNumber = atoi(tleField(line1, 3, 7))
+	e.Classification = tleField(line1, 8, 8)
+	e.InternationalDesignator = tleField(line1, 10, 17)
+	e.Epoch = tleEpoch(atoi(tleField(line1, 19, 20)), atof(tleField(line1, 21, 32)))
+	e.MeanMotionDot = atof(strings.Replace(tleField(line1, 34, 43), " ", "", -1))
+	e.MeanMotionDDot = atoe(line1[44:52])
+	e.BStar = atoe(line1[53:61])
+	e.ElementSetNumber = atoi(tleField(line1, 65, 68))
+
+	if catalog := atoi(tleField(line2, 3, 7)); catalog != e.CatalogNumber {
+		return Elements{}, ErrInvalidTLE
+	}
+	e.Inclination = atof(tleField(line2, 9, 16))
+	e.RightAscension = atof(tleField(line2, 18, 25))
+	e.Eccentricity = atof("0." + tleField(line2, 27, 33))
+	e.ArgumentOfPerigee = atof(tleField(line2, 35, 42))
+	e.MeanAnomaly = atof(tleField(line2, 44, 51))
+	e.MeanMotion = atof(tleField(line2, 53, 63))
+	e.RevolutionNumber = atoi(tleField(line2, 64, 68))
+
+	for _, err := range errs {
+		if err != nil {
+			return Elements{}, ErrInvalidTLE
+		}
+	}
+	return e, nil
+}
+
+// ReadTLEs will read every Two-Line Element set from the reader, such as a
+// file downloaded from CelesTrak. Both the two line and three line (with a
+// name on the line before) formats are understood. Blank lines are ignored.
+func ReadTLEs(r io.Reader) ([]Elements, error) {
+	var (
+		ret     = []Elements{}
+		scanner = bufio.NewScanner(r)
+		name    string
+		line1   string
+	)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \r")
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+		case strings.HasPrefix(line, "1 ") && line1 == "":
+			line1 = line
+		case strings.HasPrefix(line, "2 ") && line1 != "":
+			e, err := ParseTLE(name, line1, line)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, e)
+			name, line1 = "", ""
+		case line1 == "":
+			name = strings.TrimPrefix(line, "0 ")
+		default:
+			return nil, ErrInvalidTLE
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if line1 != "" {
+		return nil, ErrInvalidTLE
+	}
+	return ret, nil
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,394 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package sigmf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"hz.tools/rf"
+)
+
+// Version is the SigMF specification version written by this package.
+const Version = "1.0.0"
+
+// ErrInvalidMeta will be returned when a metadata file is missing a
+// required field, a field has the wrong type, or the captures are not
+// sorted by sample start.
+var ErrInvalidMeta = fmt.Errorf("sigmf: invalid metadata")
+
+// fields are the raw fields of a SigMF JSON object.
+type fields map[string]json.RawMessage
+
+// decode will decode the field into v, if it's present, and delete it so
+// that only the unknown fields remain.
+func (f fields) decode(key string, v interface{}) error {
+	raw, ok := f[key]
+	if !ok {
+		return nil
+	}
+	delete(f, key)
+	if err := json.Unmarshal(raw, v); err != nil {
+		return ErrInvalidMeta
+	}
+	return nil
+}
+
+// decodeHz will decode a numeric field as rf.Hz.
+func (f fields) decodeHz(key string, h *rf.Hz) error {
+	var v float64
+	if err := f.decode(key, &v); err != nil {
+		return err
+	}
+	*h = rf.Hz(v)
+	return nil
+}
+
+// encode will return a JSON object of the Extra fields, overlaid with the
+// provided core fields.
+func encode(extra map[string]json.RawMessage, core map[string]interface{}) map[string]interface{} {
+	ret := map[string]interface{}{}
+	for k, v := range extra {
+		ret[k] = v
+	}
+	for k, v := range core {
+		ret[k] = v
+	}
+	return ret
+}
+
+// Global is the global object, which describes the whole recording.
+type Global struct {
+	// DataType of the samples, such as "cf32_le" or "ci16_le".
+	DataType string
+
+	// SampleRate of the recording.
+	SampleRate rf.Hz
+
+	// Version of the SigMF specification.
+	Version string
+
+	// Description, Author, Hardware, Recorder and License are free-form
+	// text, and are omitted when empty.
+	Description string
+	Author      string
+	Hardware    string
+	Recorder    string
+	License     string
+
+	// Extra are any fields not listed above.
+	Extra map[string]json.RawMessage
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (g *Global) UnmarshalJSON(data []byte) error {
+	f := fields{}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	for _, err := range []error{
+		f.decode("core:datatype", &g.DataType),
+		f.decodeHz("core:sample_rate", &g.SampleRate),
+		f.decode("core:version", &g.Version),
+		f.decode("core:description", &g.Description),
+		f.decode("core:author", &g.Author),
+		f.decode("core:hw", &g.Hardware),
+		f.decode("core:recorder", &g.Recorder),
+		f.decode("core:license", &g.License),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	if g.DataType == "" {
+		return ErrInvalidMeta
+	}
+	g.Extra = f
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (g Global) MarshalJSON() ([]byte, error) {
+	version := g.Version
+	if version == "" {
+		version = Version
+	}
+	core := map[string]interface{}{
+		"core:datatype": g.DataType,
+		"core:version":  version,
+	}
+	if g.SampleRate != 0 {
+		core["core:sample_rate"] = float64(g.SampleRate)
+	}
+	for key, value := range map[string]string{
+		"core:description": g.Description,
+		"core:author":      g.Author,
+		"core:hw":          g.Hardware,
+		"core:recorder":    g.Recorder,
+		"core:license":     g.License,
+	} {
+		if value != "" {
+			core[key] = value
+		}
+	}
+	return json.Marshal(encode(g.Extra, core))
+}
+
+// Capture is a capture segment, which starts at SampleStart, and lasts
+// until the next Capture or the end of the recording.
+type Capture struct {
+	// SampleStart is the index of the first sample of the segment.
+	SampleStart uint64
+
+	// Frequency the radio was tuned to, which is at DC in the samples.
+	Frequency rf.Hz
+
+	// DateTime of the first sample, if known.
+	DateTime time.Time
+
+	// Extra are any fields not listed above.
+	Extra map[string]json.RawMessage
+}
+
+// Range will return the range of frequencies captured, given the sample
+// rate of the recording.
+func (c Capture) Range(sampleRate rf.Hz) rf.Range {
+	half := sampleRate / 2
+	return rf.Range{-half, half}.Add(c.Frequency)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *Capture) UnmarshalJSON(data []byte) error {
+	f := fields{}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	var datetime string
+	for _, err := range []error{
+		f.decode("core:sample_start", &c.SampleStart),
+		f.decodeHz("core:frequency", &c.Frequency),
+		f.decode("core:datetime", &datetime),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	if datetime != "" {
+		t, err := time.Parse(time.RFC3339Nano, datetime)
+		if err != nil {
+			return ErrInvalidMeta
+		}
+		c.DateTime = t
+	}
+	c.Extra = f
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c Capture) MarshalJSON() ([]byte, error) {
+	core := map[string]interface{}{
+		"core:sample_start": c.SampleStart,
+	}
+	if c.Frequency != 0 {
+		core["core:frequency"] = float64(c.Frequency)
+	}
+	if !c.DateTime.IsZero() {
+		core["core:datetime"] = c.DateTime.UTC().Format(time.RFC3339Nano)
+	}
+	return json.Marshal(encode(c.Extra, core))
+}
+
+// Annotation describes a span of samples, and optionally the frequencies a
+// signal occupies within it.
+type Annotation struct {
+	// SampleStart is the index of the first sample of the annotation.
+	SampleStart uint64
+
+	// SampleCount is the number of samples annotated, or zero to run to
+	// the end of the recording.
+	SampleCount uint64
+
+	// Range is the core:freq_lower_edge and core:freq_upper_edge of the
+	// annotation. The zero value is omitted.
+	Range rf.Range
+
+	// Label is a short description of the annotation.
+	Label string
+
+	// Comment is a longer, free-form description.
+	Comment string
+
+	// Extra are any fields not listed above.
+	Extra map[string]json.RawMessage
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (a *Annotation) UnmarshalJSON(data []byte) error {
+	f := fields{}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	if _, ok := f["core:sample_start"]; !ok {
+		return ErrInvalidMeta
+	}
+	for _, err := range []error{
+		f.decode("core:sample_start", &a.SampleStart),
+		f.decode("core:sample_count", &a.SampleCount),
+		f.decodeHz("core:freq_lower_edge", &a.Range[0]),
+		f.decodeHz("core:freq_upper_edge", &a.Range[1]),
+		f.decode("core:label", &a.Label),
+		f.decode("core:comment", &a.Comment),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	a.Extra = f
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a Annotation) MarshalJSON() ([]byte, error) {
+	core := map[string]interface{}{
+		"core:sample_start": a.SampleStart,
+	}
+	if a.SampleCount != 0 {
+		core["core:sample_count"] = a.SampleCount
+	}
+	if a.Range != (rf.Range{}) {
+		core["core:freq_lower_edge"] = float64(a.Range[0])
+		core["core:freq_upper_edge"] = float64(a.Range[1])
+	}
+	if a.Label != "" {
+		core["core:label"] = a.Label
+	}
+	if a.Comment != "" {
+		core["core:comment"] = a.Comment
+	}
+	return json.Marshal(encode(a.Extra, core))
+}
+
+// Meta is the contents of a .sigmf-meta file.
+type Meta struct {
+	Global      Global       `json:"global"`
+	Captures    []Capture    `json:"captures"`
+	Annotations []Annotation `json:"annotations"`
+}
+
+// Read will read a .sigmf-meta file. As required by SigMF, the captures
+// must be sorted by sample start.
+func Read(r io.Reader) (Meta, error) {
+	m := Meta{}
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return Meta{}, err
+	}
+	for i := 1; i < len(m.Captures); i++ {
+		if m.Captures[i].SampleStart <= m.Captures[i-1].SampleStart {
+			return Meta{}, ErrInvalidMeta
+		}
+	}
+	if m.Captures == nil {
+		m.Captures = []Capture{}
+	}
+	if m.Annotations == nil {
+		m.Annotations = []Annotation{}
+	}
+	return m, nil
+}
+
+// Write will write the Meta as an indented .sigmf-meta file.
+func (m Meta) Write(w io.Writer) error {
+	if m.Captures == nil {
+		m.Captures = []Capture{}
+	}
+	if m.Annotations == nil {
+		m.Annotations = []Annotation{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	return enc.Encode(m)
+}
+
+// Range will return the range of frequencies covered by every Capture.
+func (m Meta) Range() rf.Range {
+	var ret rf.Range
+	for i, c := range m.Captures {
+		r := c.Range(m.Global.SampleRate)
+		if i == 0 || r[0] < ret[0] {
+			ret[0] = r[0]
+		}
+		if i == 0 || r[1] > ret[1] {
+			ret[1] = r[1]
+		}
+	}
+	return ret
+}
+
+// Annotate will return a copy of the Meta with an Annotation added for
+// every Allocation that overlaps the bandwidth of each Capture, covering
+// the samples of that Capture. The Annotation's Range is the part of the
+// Allocation within the captured bandwidth, and its Label is the name of the
+// Allocation. Allocations which only touch the edge of the captured
+// bandwidth are skipped.
+func (m Meta) Annotate(allocations rf.Allocations) Meta {
+	annotations := append([]Annotation{}, m.Annotations...)
+	captures := append([]Capture{}, m.Captures...)
+	sort.SliceStable(captures, func(i, j int) bool {
+		return captures[i].SampleStart < captures[j].SampleStart
+	})
+	for i, c := range captures {
+		captured := c.Range(m.Global.SampleRate)
+		count := uint64(0)
+		if i+1 < len(captures) {
+			count = captures[i+1].SampleStart - c.SampleStart
+		}
+		for _, allocation := range allocations {
+			if !captured.Overlaps(allocation.Range) {
+				continue
+			}
+			r := captured.Intersection(allocation.Range)
+			if r == (rf.Range{}) {
+				if allocation.Range[0] != allocation.Range[1] {
+					// Only the edges touch.
+					continue
+				}
+				// A single frequency allocation, such as a channel.
+				r = allocation.Range
+			}
+			annotations = append(annotations, Annotation{
+				SampleStart: c.SampleStart,
+				SampleCount: count,
+				Range:       r,
+				Label:       allocation.Name,
+			})
+		}
+	}
+	sort.SliceStable(annotations, func(i, j int) bool {
+		return annotations[i].SampleStart < annotations[j].SampleStart
+	})
+	m.Annotations = annotations
+	return m
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,146 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package sigmf_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/sigmf"
+)
+
+const recording = `{
+    "global": {
+        "core:datatype": "cf32_le",
+        "core:sample_rate": 2400000,
+        "core:version": "1.0.0",
+        "core:hw": "rtl-sdr",
+        "antenna:type": "dipole"
+    },
+    "captures": [
+        {"core:sample_start": 0, "core:frequency": 146000000, "core:datetime": "2026-10-18T21:30:00.5Z"},
+        {"core:sample_start": 24000000, "core:frequency": 162500000}
+    ],
+    "annotations": [
+        {"core:sample_start": 100, "core:sample_count": 200, "core:freq_lower_edge": 146512500, "core:freq_upper_edge": 146527500, "core:label": "FM"}
+    ]
+}`
+
+func TestRead(t *testing.T) {
+	m, err := sigmf.Read(strings.NewReader(recording))
+	assert.NoError(t, err)
+	assert.Equal(t, "cf32_le", m.Global.DataType)
+	assert.Equal(t, rf.KHz*2400, m.Global.SampleRate)
+	assert.Equal(t, "rtl-sdr", m.Global.Hardware)
+	assert.Equal(t, `"dipole"`, string(m.Global.Extra["antenna:type"]))
+
+	assert.Equal(t, 2, len(m.Captures))
+	assert.Equal(t, rf.MHz*146, m.Captures[0].Frequency)
+	assert.Equal(t, time.Date(2026, 10, 18, 21, 30, 0, 500000000, time.UTC), m.Captures[0].DateTime)
+	assert.Equal(t, rf.Range{rf.KHz * 144800, rf.KHz * 147200}, m.Captures[0].Range(m.Global.SampleRate))
+	assert.Equal(t, rf.Range{rf.KHz * 144800, rf.KHz * 163700}, m.Range())
+
+	assert.Equal(t, rf.Range{rf.KHz * 146512.5, rf.KHz * 146527.5}, m.Annotations[0].Range)
+	assert.Equal(t, "FM", m.Annotations[0].Label)
+
+	_, err = sigmf.Read(strings.NewReader(`{"global": {}}`))
+	assert.Equal(t, sigmf.ErrInvalidMeta, err)
+
+	_, err = sigmf.Read(strings.NewReader(`{
+		"global": {"core:datatype": "cf32_le", "core:version": "1.0.0"},
+		"captures": [
+			{"core:sample_start": 100, "core:frequency": 146000000},
+			{"core:sample_start": 0, "core:frequency": 162500000}
+		]
+	}`))
+	assert.Equal(t, sigmf.ErrInvalidMeta, err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	m, err := sigmf.Read(strings.NewReader(recording))
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, m.Write(&buf))
+	assert.Contains(t, buf.String(), `"antenna:type": "dipole"`)
+	assert.Contains(t, buf.String(), `"core:frequency": 162500000`)
+
+	again, err := sigmf.Read(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, m, again)
+}
+
+func TestAnnotate(t *testing.T) {
+	m, err := sigmf.Read(strings.NewReader(recording))
+	assert.NoError(t, err)
+
+	m = m.Annotate(rf.Allocations{
+		{Name: "2m", Range: rf.Range{rf.MHz * 144, rf.MHz * 148}},
+		{Name: "NOAA WX1", Range: rf.Range{rf.KHz * 162537.5, rf.KHz * 162562.5}},
+		{Name: "70cm", Range: rf.Range{rf.MHz * 420, rf.MHz * 450}},
+	})
+	assert.Equal(t, 3, len(m.Annotations))
+
+	assert.Equal(t, "2m", m.Annotations[0].Label)
+	assert.Equal(t, uint64(0), m.Annotations[0].SampleStart)
+	assert.Equal(t, uint64(24000000), m.Annotations[0].SampleCount)
+	assert.Equal(t, rf.Range{rf.KHz * 144800, rf.KHz * 147200}, m.Annotations[0].Range)
+
+	assert.Equal(t, "FM", m.Annotations[1].Label)
+
+	assert.Equal(t, "NOAA WX1", m.Annotations[2].Label)
+	assert.Equal(t, uint64(24000000), m.Annotations[2].SampleStart)
+	assert.Equal(t, uint64(0), m.Annotations[2].SampleCount)
+}
+
+func TestAnnotateEdges(t *testing.T) {
+	m := sigmf.Meta{
+		Global: sigmf.Global{SampleRate: rf.MHz * 2},
+		Captures: []sigmf.Capture{
+			{SampleStart: 1000, Frequency: rf.MHz * 103},
+			{SampleStart: 0, Frequency: rf.MHz * 101},
+		},
+	}
+
+	m = m.Annotate(rf.Allocations{
+		{Name: "FM", Range: rf.Range{rf.MHz * 88, rf.MHz * 100}},
+		{Name: "Edge", Range: rf.Range{rf.MHz * 102, rf.MHz * 102}},
+		{Name: "Channel", Range: rf.Range{rf.KHz * 101100, rf.KHz * 101100}},
+	})
+	assert.Equal(t, 3, len(m.Annotations))
+
+	assert.Equal(t, uint64(0), m.Annotations[0].SampleStart)
+	assert.Equal(t, uint64(1000), m.Annotations[0].SampleCount)
+	assert.Equal(t, "Edge", m.Annotations[0].Label)
+	assert.Equal(t, "Channel", m.Annotations[1].Label)
+	assert.Equal(t, rf.Range{rf.KHz * 101100, rf.KHz * 101100}, m.Annotations[1].Range)
+
+	assert.Equal(t, uint64(1000), m.Annotations[2].SampleStart)
+	assert.Equal(t, uint64(0), m.Annotations[2].SampleCount)
+	assert.Equal(t, "Edge", m.Annotations[2].Label)
+}
+
+// vim: foldmethod=marker
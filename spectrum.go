@@ -0,0 +1,232 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package rf
+
+import (
+	"math"
+	"sort"
+)
+
+// Spectrum is a set of power measurements, in dB (such as dBm or dBFS), at
+// increasing frequencies. The frequencies may be uniformly spaced, such as
+// the bins of an FFT, or not, such as a set of merged sweeps.
+type Spectrum struct {
+	// Frequencies of each measurement, in increasing order.
+	Frequencies []Hz
+
+	// Power of each measurement, in dB.
+	Power []float64
+}
+
+// NewSpectrum will create a Spectrum from the bins of an FFT, putting the
+// bins into frequency order if the axis isn't already Shifted.
+func NewSpectrum(axis SpectrumAxis, power []float64) Spectrum {
+	s := Spectrum{
+		Frequencies: make([]Hz, len(power)),
+		Power:       make([]float64, len(power)),
+	}
+	for i := range power {
+		j := axis.index(i)
+		s.Frequencies[i] = axis.Frequency(float64(j))
+		s.Power[i] = power[j]
+	}
+	return s
+}
+
+// Len will return the number of measurements.
+func (s Spectrum) Len() int {
+	return len(s.Frequencies)
+}
+
+// Range will return the range from the lowest to the highest frequency.
+func (s Spectrum) Range() Range {
+	if len(s.Frequencies) == 0 {
+		return Range{}
+	}
+	return Range{s.Frequencies[0], s.Frequencies[len(s.Frequencies)-1]}
+}
+
+// Slice will return the measurements whose frequency is within the Range.
+// The returned Spectrum shares memory with s.
+func (s Spectrum) Slice(r Range) Spectrum {
+	lo := sort.Search(len(s.Frequencies), func(i int) bool {
+		return s.Frequencies[i] >= r[0]
+	})
+	hi := sort.Search(len(s.Frequencies), func(i int) bool {
+		return s.Frequencies[i] > r[1]
+	})
+	return Spectrum{Frequencies: s.Frequencies[lo:hi], Power: s.Power[lo:hi]}
+}
+
+// At will return the power at the frequency, linearly interpolated between
+// the closest measurements, and false if the frequency is outside the
+// Range.
+func (s Spectrum) At(freq Hz) (float64, bool) {
+	if len(s.Frequencies) == 0 || !s.Range().ContainsFrequency(freq) {
+		return 0, false
+	}
+	i := sort.Search(len(s.Frequencies), func(i int) bool {
+		return s.Frequencies[i] >= freq
+	})
+	if s.Frequencies[i] == freq {
+		return s.Power[i], true
+	}
+	f0, f1 := s.Frequencies[i-1], s.Frequencies[i]
+	p0, p1 := s.Power[i-1], s.Power[i]
+	return p0 + (p1-p0)*float64((freq-f0)/(f1-f0)), true
+}
+
+// Resample will return the Spectrum linearly interpolated onto uniformly
+// spaced frequencies, step apart, starting at the lowest frequency.
+func (s Spectrum) Resample(step Hz) Spectrum {
+	return MergeSpectra(step, s)
+}
+
+// dbToLinear and linearToDB convert between dB and linear power ratios.
+func dbToLinear(db float64) float64  { return math.Pow(10, db/10) }
+func linearToDB(lin float64) float64 { return 10 * math.Log10(lin) }
+
+// MergeSpectra will combine spectra, such as overlapping sweeps, onto
+// uniformly spaced frequencies step apart, from the lowest to the highest
+// frequency of any of them. Where spectra overlap, the power is averaged
+// (in linear terms, not dB). Frequencies that aren't covered by any of the
+// spectra, such as a gap between two sweeps, are omitted.
+func MergeSpectra(step Hz, spectra ...Spectrum) Spectrum {
+	ret := Spectrum{Frequencies: []Hz{}, Power: []float64{}}
+	var r Range
+	first := true
+	for _, s := range spectra {
+		if s.Len() == 0 {
+			continue
+		}
+		sr := s.Range()
+		if first || sr[0] < r[0] {
+			r[0] = sr[0]
+		}
+		if first || sr[1] > r[1] {
+			r[1] = sr[1]
+		}
+		first = false
+	}
+	if first || step <= 0 {
+		return ret
+	}
+
+	steps := int(math.Floor(float64((r[1]-r[0])/step) + 1e-9))
+	for i := 0; i <= steps; i++ {
+		freq := r[0] + Hz(i)*step
+		total, n := 0.0, 0
+		for _, s := range spectra {
+			if p, ok := s.At(freq); ok {
+				total += dbToLinear(p)
+				n++
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		ret.Frequencies = append(ret.Frequencies, freq)
+		ret.Power = append(ret.Power, linearToDB(total/float64(n)))
+	}
+	return ret
+}
+
+// NoiseFloor will estimate the noise floor as the median power, which
+// holds up well as long as signals occupy less than half the Spectrum.
+func (s Spectrum) NoiseFloor() float64 {
+	if len(s.Power) == 0 {
+		return math.NaN()
+	}
+	sorted := append([]float64{}, s.Power...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// DetectedSignal is a signal detected in a Spectrum.
+type DetectedSignal struct {
+	// Range of frequencies the signal was detected over.
+	Range Range
+
+	// Peak is the frequency of the strongest measurement.
+	Peak Hz
+
+	// Power at the Peak, in dB.
+	Power float64
+
+	// Labels are the Allocations that overlap the signal, set by Label.
+	Labels Allocations
+}
+
+// Detect will return every run of measurements at least threshold dB above
+// the NoiseFloor as a DetectedSignal. Each DetectedSignal's Range extends
+// halfway to the neighbouring measurements that are below the threshold.
+func (s Spectrum) Detect(threshold float64) []DetectedSignal {
+	level := s.NoiseFloor() + threshold
+	ret := []DetectedSignal{}
+
+	edge := func(i, j int) Hz {
+		if j < 0 || j >= len(s.Frequencies) {
+			return s.Frequencies[i]
+		}
+		return (s.Frequencies[i] + s.Frequencies[j]) / 2
+	}
+
+	for i := 0; i < len(s.Power); i++ {
+		if s.Power[i] < level {
+			continue
+		}
+		start, peak := i, i
+		for ; i < len(s.Power) && s.Power[i] >= level; i++ {
+			if s.Power[i] > s.Power[peak] {
+				peak = i
+			}
+		}
+		end := i - 1
+		ret = append(ret, DetectedSignal{
+			Range: Range{edge(start, start-1), edge(end, end+1)},
+			Peak:  s.Frequencies[peak],
+			Power: s.Power[peak],
+		})
+	}
+	return ret
+}
+
+// Label will set the Labels of each DetectedSignal to the Allocations that overlap
+// its Range, such as a band plan or a list of well-known signals.
+func Label(signals []DetectedSignal, allocations Allocations) []DetectedSignal {
+	ret := make([]DetectedSignal, len(signals))
+	for i, signal := range signals {
+		signal.Labels = Allocations{}
+		for _, allocation := range allocations {
+			if allocation.Range.Overlaps(signal.Range) {
+				signal.Labels = append(signal.Labels, allocation)
+			}
+		}
+		ret[i] = signal
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
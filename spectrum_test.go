@@ -0,0 +1,96 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package rf_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+)
+
+func TestNewSpectrum(t *testing.T) {
+	axis := rf.SpectrumAxis{Center: rf.MHz * 100, SampleRate: rf.KHz * 4, Size: 4}
+	s := rf.NewSpectrum(axis, []float64{0, 1, 2, 3})
+	assert.Equal(t, []rf.Hz{
+		rf.KHz * 99998, rf.KHz * 99999, rf.KHz * 100000, rf.KHz * 100001,
+	}, s.Frequencies)
+	assert.Equal(t, []float64{2, 3, 0, 1}, s.Power)
+	assert.Equal(t, rf.Range{rf.KHz * 99998, rf.KHz * 100001}, s.Range())
+}
+
+func TestSpectrumSliceAt(t *testing.T) {
+	s := rf.Spectrum{
+		Frequencies: []rf.Hz{100, 200, 300, 400},
+		Power:       []float64{-10, -20, -30, -40},
+	}
+	assert.Equal(t, []rf.Hz{200, 300}, s.Slice(rf.Range{150, 300}).Frequencies)
+
+	p, ok := s.At(250)
+	assert.True(t, ok)
+	assert.Equal(t, -25.0, p)
+	_, ok = s.At(450)
+	assert.False(t, ok)
+
+	r := s.Resample(50)
+	assert.Equal(t, 7, r.Len())
+	assert.InDelta(t, -15, r.Power[1], 1e-9)
+}
+
+func TestMergeSpectra(t *testing.T) {
+	a := rf.Spectrum{Frequencies: []rf.Hz{0, 10, 20}, Power: []float64{-10, -10, -10}}
+	b := rf.Spectrum{Frequencies: []rf.Hz{20, 30, 40}, Power: []float64{-20, -20, -20}}
+	c := rf.Spectrum{Frequencies: []rf.Hz{60, 70}, Power: []float64{0, 0}}
+
+	m := rf.MergeSpectra(10, a, b, c)
+	assert.Equal(t, []rf.Hz{0, 10, 20, 30, 40, 60, 70}, m.Frequencies)
+	assert.InDelta(t, -12.596, m.Power[2], 0.001)
+	assert.InDelta(t, -20, m.Power[3], 1e-9)
+}
+
+func TestDetect(t *testing.T) {
+	s := rf.Spectrum{}
+	for i := 0; i < 100; i++ {
+		s.Frequencies = append(s.Frequencies, rf.KHz*144000+rf.KHz*rf.Hz(i*10))
+		s.Power = append(s.Power, -100)
+	}
+	for i := 39; i <= 41; i++ {
+		s.Power[i] = -60
+	}
+	s.Power[40] = -50
+
+	assert.Equal(t, -100.0, s.NoiseFloor())
+	signals := s.Detect(10)
+	assert.Equal(t, 1, len(signals))
+	assert.Equal(t, rf.Range{rf.KHz * 144385, rf.KHz * 144415}, signals[0].Range)
+	assert.Equal(t, rf.KHz*144400, signals[0].Peak)
+	assert.Equal(t, -50.0, signals[0].Power)
+
+	labeled := rf.Label(signals, rf.Allocations{
+		{Name: "2m Calling", Range: rf.Range{rf.KHz * 144390, rf.KHz * 144390}},
+		{Name: "Elsewhere", Range: rf.Range{rf.MHz * 146, rf.MHz * 147}},
+	})
+	assert.Equal(t, 1, len(labeled[0].Labels))
+	assert.Equal(t, "2m Calling", labeled[0].Labels[0].Name)
+}
+
+// vim: foldmethod=marker
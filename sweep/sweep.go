@@ -0,0 +1,278 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package sweep
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"hz.tools/rf"
+)
+
+// ErrInvalidRow will be returned when a CSV row is not well formed.
+var ErrInvalidRow = fmt.Errorf("sweep: invalid row")
+
+// Format is the tool that wrote a CSV file. The columns are the same for
+// every Format -- date, time, Hz low, Hz high, Hz step, samples, and then
+// the power of each bin in dB -- but the position of the bins differs.
+type Format int
+
+const (
+	// RTLPower is the format written by rtl_power, where bin i is at
+	// Hz low + i × Hz step.
+	RTLPower Format = iota
+
+	// SoapyPower is the rtl_power compatible format written by
+	// soapy_power, which places the bins the same way as rtl_power.
+	SoapyPower
+
+	// HackRFSweep is the format written by hackrf_sweep, where bin i
+	// covers Hz low + i × Hz step to Hz low + (i+1) × Hz step, so its
+	// center is half a step higher than rtl_power's.
+	HackRFSweep
+)
+
+// String will return the name of the tool.
+func (f Format) String() string {
+	switch f {
+	case RTLPower:
+		return "rtl_power"
+	case SoapyPower:
+		return "soapy_power"
+	case HackRFSweep:
+		return "hackrf_sweep"
+	}
+	return "unknown"
+}
+
+// offset will return the position of a bin's center, in steps from the
+// start of the bin.
+func (f Format) offset() float64 {
+	if f == HackRFSweep {
+		return 0.5
+	}
+	return 0
+}
+
+// Row is a single row of a sweep CSV file, which covers one hop of the
+// sweep.
+type Row struct {
+	// Format of the file the Row was read from.
+	Format Format
+
+	// Time the row was captured.
+	Time time.Time
+
+	// Range from Hz low to Hz high.
+	Range rf.Range
+
+	// Step is the width of each bin.
+	Step rf.Hz
+
+	// Samples is the number of samples the bins were averaged over.
+	Samples int
+
+	// Power of each bin, in dB. Bins the tool wrote as "nan" are NaN.
+	Power []float64
+}
+
+// Frequency will return the center frequency of bin i.
+func (r Row) Frequency(i int) rf.Hz {
+	return r.Range[0] + r.Step*rf.Hz(float64(i)+r.Format.offset())
+}
+
+// Spectrum will return the bins of the Row as an rf.Spectrum. NaN bins are
+// left out, so they can't poison a merge or noise floor estimate.
+func (r Row) Spectrum() rf.Spectrum {
+	s := rf.Spectrum{Frequencies: []rf.Hz{}, Power: []float64{}}
+	for i, power := range r.Power {
+		if math.IsNaN(power) {
+			continue
+		}
+		s.Frequencies = append(s.Frequencies, r.Frequency(i))
+		s.Power = append(s.Power, power)
+	}
+	return s
+}
+
+// Sweep is every Row of a single pass over the swept Range.
+type Sweep struct {
+	// Time the first Row of the sweep was captured.
+	Time time.Time
+
+	// Rows of the sweep, in the order they were read.
+	Rows []Row
+}
+
+// Range will return the range covered by every Row of the Sweep.
+func (s Sweep) Range() rf.Range {
+	if len(s.Rows) == 0 {
+		return rf.Range{}
+	}
+	r := s.Rows[0].Range
+	for _, row := range s.Rows[1:] {
+		if row.Range[0] < r[0] {
+			r[0] = row.Range[0]
+		}
+		if row.Range[1] > r[1] {
+			r[1] = row.Range[1]
+		}
+	}
+	return r
+}
+
+// Spectrum will merge every Row of the Sweep into a single rf.Spectrum,
+// spaced by the narrowest Step, using rf.MergeSpectra.
+func (s Sweep) Spectrum() rf.Spectrum {
+	if len(s.Rows) == 0 {
+		return rf.Spectrum{}
+	}
+	step := s.Rows[0].Step
+	spectra := []rf.Spectrum{}
+	for _, row := range s.Rows {
+		if row.Step < step {
+			step = row.Step
+		}
+		spectra = append(spectra, row.Spectrum())
+	}
+	return rf.MergeSpectra(step, spectra...)
+}
+
+// Reader reads Rows or Sweeps from a sweep CSV file, one at a time.
+type Reader struct {
+	// Location the timestamps are in. The tools write local time, so this
+	// defaults to time.Local.
+	Location *time.Location
+
+	format  Format
+	csv     *csv.Reader
+	pending *Row
+}
+
+// NewReader will create a Reader for a CSV file in the provided Format.
+func NewReader(r io.Reader, format Format) *Reader {
+	c := csv.NewReader(r)
+	c.FieldsPerRecord = -1
+	c.TrimLeadingSpace = true
+	c.ReuseRecord = true
+	return &Reader{
+		Location: time.Local,
+		format:   format,
+		csv:      c,
+	}
+}
+
+// ReadRow will return the next Row, or io.EOF at the end of the file.
+func (r *Reader) ReadRow() (Row, error) {
+	if r.pending != nil {
+		row := *r.pending
+		r.pending = nil
+		return row, nil
+	}
+
+	record, err := r.csv.Read()
+	if err != nil {
+		return Row{}, err
+	}
+	if len(record) < 7 {
+		return Row{}, ErrInvalidRow
+	}
+
+	when, err := time.ParseInLocation(
+		"2006-01-02 15:04:05",
+		strings.TrimSpace(record[0])+" "+strings.TrimSpace(record[1]),
+		r.Location,
+	)
+	if err != nil {
+		return Row{}, ErrInvalidRow
+	}
+
+	var numbers [4]float64
+	for i := range numbers {
+		numbers[i], err = strconv.ParseFloat(strings.TrimSpace(record[i+2]), 64)
+		if err != nil {
+			return Row{}, ErrInvalidRow
+		}
+	}
+	if numbers[2] <= 0 {
+		return Row{}, ErrInvalidRow
+	}
+
+	power := make([]float64, len(record)-6)
+	for i, field := range record[6:] {
+		field = strings.TrimSpace(field)
+		if strings.EqualFold(field, "nan") || strings.EqualFold(field, "-nan") {
+			power[i] = math.NaN()
+			continue
+		}
+		if power[i], err = strconv.ParseFloat(field, 64); err != nil {
+			return Row{}, ErrInvalidRow
+		}
+	}
+
+	return Row{
+		Format:  r.format,
+		Time:    when,
+		Range:   rf.Range{rf.Hz(numbers[0]), rf.Hz(numbers[1])},
+		Step:    rf.Hz(numbers[2]),
+		Samples: int(numbers[3]),
+		Power:   power,
+	}, nil
+}
+
+// ReadSweep will return the next Sweep, or io.EOF at the end of the file.
+//
+// Each pass starts again from the bottom of the swept Range, so a Row that
+// starts below the first Row of the Sweep begins the next one. hackrf_sweep
+// interleaves its Rows and stamps each with its own time, so Rows within a
+// Sweep may be out of order, and have different timestamps. A Row that
+// starts at the same frequency as the first Row begins the next Sweep only
+// if it has a different timestamp, so a single hop rtl_power file gives a
+// Sweep per Row.
+func (r *Reader) ReadSweep() (Sweep, error) {
+	first, err := r.ReadRow()
+	if err != nil {
+		return Sweep{}, err
+	}
+	sweep := Sweep{Time: first.Time, Rows: []Row{first}}
+	for {
+		row, err := r.ReadRow()
+		if err == io.EOF {
+			return sweep, nil
+		}
+		if err != nil {
+			return Sweep{}, err
+		}
+		start := first.Range[0]
+		if row.Range[0] < start || (row.Range[0] == start && !row.Time.Equal(sweep.Time)) {
+			r.pending = &row
+			return sweep, nil
+		}
+		sweep.Rows = append(sweep.Rows, row)
+	}
+}
+
+// vim: foldmethod=marker
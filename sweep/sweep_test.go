@@ -0,0 +1,129 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package sweep_test
+
+import (
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/sweep"
+)
+
+const rtlPower = `2026-10-18, 21:30:00, 88000000, 89000000, 250000.00, 10, -30.5, -31.0, -29.5, -40.0
+2026-10-18, 21:30:00, 89000000, 90000000, 250000.00, 10, -50.0, -51.0, -52.0, -53.0
+2026-10-18, 21:30:10, 88000000, 89000000, 250000.00, 10, -30.0, -31.0, -29.0, -40.0
+2026-10-18, 21:30:10, 89000000, 90000000, 250000.00, 10, -50.0, -51.0, nan, -53.0
+`
+
+const hackrfSweep = `2026-10-18, 21:30:00.123456, 2400000000, 2405000000, 1000000.00, 20, -70.1, -71.2, -72.3, -73.4, -74.5
+2026-10-18, 21:30:00.123456, 2410000000, 2415000000, 1000000.00, 20, -70.0, -70.0, -70.0, -70.0, -70.0
+2026-10-18, 21:30:00.124998, 2405000000, 2410000000, 1000000.00, 20, -70.0, -70.0, -30.0, -70.0, -70.0
+2026-10-18, 21:30:00.124998, 2415000000, 2420000000, 1000000.00, 20, -70.0, -70.0, -70.0, -70.0, nan
+2026-10-18, 21:30:00.126541, 2400000000, 2405000000, 1000000.00, 20, -70.0, -70.0, -70.0, -70.0, -70.0
+2026-10-18, 21:30:00.126541, 2410000000, 2415000000, 1000000.00, 20, -70.0, -70.0, -70.0, -70.0, -70.0
+`
+
+func TestRTLPower(t *testing.T) {
+	r := sweep.NewReader(strings.NewReader(rtlPower), sweep.RTLPower)
+	r.Location = time.UTC
+
+	row, err := r.ReadRow()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 18, 21, 30, 0, 0, time.UTC), row.Time)
+	assert.Equal(t, rf.Range{rf.MHz * 88, rf.MHz * 89}, row.Range)
+	assert.Equal(t, rf.KHz*250, row.Step)
+	assert.Equal(t, 10, row.Samples)
+	assert.Equal(t, []float64{-30.5, -31.0, -29.5, -40.0}, row.Power)
+	assert.Equal(t, rf.KHz*88250, row.Frequency(1))
+
+	s, err := r.ReadSweep()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(s.Rows))
+
+	s, err = r.ReadSweep()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(s.Rows))
+	assert.Equal(t, time.Date(2026, 10, 18, 21, 30, 10, 0, time.UTC), s.Time)
+	assert.Equal(t, rf.Range{rf.MHz * 88, rf.MHz * 90}, s.Range())
+
+	spectrum := s.Spectrum()
+	assert.Equal(t, 8, spectrum.Len())
+	assert.Equal(t, rf.MHz*88, spectrum.Frequencies[0])
+	assert.Equal(t, rf.KHz*89750, spectrum.Frequencies[7])
+	assert.Equal(t, 3, s.Rows[1].Spectrum().Len())
+	assert.False(t, math.IsNaN(spectrum.NoiseFloor()))
+	for _, power := range spectrum.Power {
+		assert.False(t, math.IsNaN(power))
+	}
+
+	_, err = r.ReadSweep()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestHackRFSweep(t *testing.T) {
+	r := sweep.NewReader(strings.NewReader(hackrfSweep), sweep.HackRFSweep)
+	r.Location = time.UTC
+	row, err := r.ReadRow()
+	assert.NoError(t, err)
+	assert.Equal(t, 123456000, row.Time.Nanosecond())
+	assert.Equal(t, 5, len(row.Power))
+	assert.Equal(t, rf.KHz*2400500, row.Frequency(0))
+	assert.Equal(t, rf.KHz*2404500, row.Spectrum().Frequencies[4])
+
+	// A full pass, from the bottom of the band.
+	r = sweep.NewReader(strings.NewReader(hackrfSweep), sweep.HackRFSweep)
+	s, err := r.ReadSweep()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(s.Rows))
+	assert.Equal(t, rf.Range{rf.MHz * 2400, rf.MHz * 2420}, s.Range())
+
+	spectrum := s.Spectrum()
+	assert.Equal(t, 19, spectrum.Len())
+	assert.Equal(t, rf.KHz*2400500, spectrum.Frequencies[0])
+	assert.Equal(t, rf.KHz*2418500, spectrum.Frequencies[18])
+	for _, power := range spectrum.Power {
+		assert.False(t, math.IsNaN(power))
+	}
+	signals := spectrum.Detect(20)
+	assert.Equal(t, 1, len(signals))
+	assert.Equal(t, rf.KHz*2407500, signals[0].Peak)
+
+	s, err = r.ReadSweep()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(s.Rows))
+
+	_, err = r.ReadSweep()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestInvalidRow(t *testing.T) {
+	r := sweep.NewReader(strings.NewReader("2026-10-18, 21:30:00, 88000000\n"), sweep.SoapyPower)
+	_, err := r.ReadRow()
+	assert.Equal(t, sweep.ErrInvalidRow, err)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,544 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package touchstone
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"math/cmplx"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+var (
+	// ErrInvalidFile will be returned when a Touchstone file is not well
+	// formed.
+	ErrInvalidFile = fmt.Errorf("touchstone: invalid file")
+
+	// ErrUnsupported will be returned for Touchstone features that aren't
+	// supported, such as mixed-mode parameters.
+	ErrUnsupported = fmt.Errorf("touchstone: unsupported feature")
+
+	// ErrOutOfRange will be returned when interpolating at a frequency
+	// outside of the Network's Points.
+	ErrOutOfRange = fmt.Errorf("touchstone: frequency out of range")
+
+	// ErrMixedReference will be returned when writing a version 1 file for
+	// a Network whose ports have different reference impedances, which
+	// only version 2 can represent.
+	ErrMixedReference = fmt.Errorf("touchstone: version 1 files have a single reference impedance")
+)
+
+// Format is how each complex value is written in a Touchstone file.
+type Format string
+
+const (
+	// RI is real and imaginary parts.
+	RI Format = "RI"
+
+	// MA is linear magnitude and angle, in degrees.
+	MA Format = "MA"
+
+	// DB is magnitude in dB (20 × log10) and angle, in degrees.
+	DB Format = "DB"
+)
+
+// decode will convert a pair of numbers in the Format to a complex value.
+func (f Format) decode(a, b float64) complex128 {
+	switch f {
+	case RI:
+		return complex(a, b)
+	case DB:
+		a = math.Pow(10, a/20)
+	}
+	return cmplx.Rect(a, b*math.Pi/180)
+}
+
+// encode will convert a complex value to a pair of numbers in the Format.
+func (f Format) encode(v complex128) (float64, float64) {
+	switch f {
+	case RI:
+		return real(v), imag(v)
+	case DB:
+		return 20 * math.Log10(cmplx.Abs(v)), cmplx.Phase(v) * 180 / math.Pi
+	}
+	return cmplx.Abs(v), cmplx.Phase(v) * 180 / math.Pi
+}
+
+// Point is the network parameters at a single frequency.
+type Point struct {
+	// Frequency of the measurement.
+	Frequency rf.Hz
+
+	// Data is the parameter matrix, where Data[i][j] is the parameter from
+	// port j+1 to port i+1, such that Data[1][0] is S21.
+	Data [][]complex128
+}
+
+// Value will return the parameter from port j to port i, numbered from 1
+// as in the usual notation, such that Value(2, 1) is S21.
+func (p Point) Value(i, j int) complex128 {
+	return p.Data[i-1][j-1]
+}
+
+// Network is the contents of a Touchstone file.
+type Network struct {
+	// Version of the file format, 1 or 2.
+	Version int
+
+	// Ports is the number of ports of the network.
+	Ports int
+
+	// Parameter is the type of network parameter, such as "S", "Y" or "Z".
+	Parameter string
+
+	// Format the values are written in.
+	Format Format
+
+	// Unit of the frequencies in the file, such as rf.GHz.
+	Unit rf.Hz
+
+	// Reference is the reference impedance of each port, in Ohms.
+	Reference []float64
+
+	// Points of the network, in order of increasing frequency.
+	Points []Point
+}
+
+// Range will return the range from the lowest to the highest frequency.
+func (n Network) Range() rf.Range {
+	if len(n.Points) == 0 {
+		return rf.Range{}
+	}
+	return rf.Range{n.Points[0].Frequency, n.Points[len(n.Points)-1].Frequency}
+}
+
+// At will return the parameter matrix at the frequency, linearly
+// interpolating the real and imaginary parts between the closest Points.
+func (n Network) At(freq rf.Hz) ([][]complex128, error) {
+	if len(n.Points) == 0 || !n.Range().ContainsFrequency(freq) {
+		return nil, ErrOutOfRange
+	}
+	i := sort.Search(len(n.Points), func(i int) bool {
+		return n.Points[i].Frequency >= freq
+	})
+	if n.Points[i].Frequency == freq {
+		return n.Points[i].Data, nil
+	}
+	p0, p1 := n.Points[i-1], n.Points[i]
+	t := complex(float64((freq-p0.Frequency)/(p1.Frequency-p0.Frequency)), 0)
+	ret := make([][]complex128, n.Ports)
+	for row := range ret {
+		ret[row] = make([]complex128, n.Ports)
+		for col := range ret[row] {
+			a, b := p0.Data[row][col], p1.Data[row][col]
+			ret[row][col] = a + (b-a)*t
+		}
+	}
+	return ret, nil
+}
+
+// unit will parse a Touchstone frequency unit using rf.ParseHz.
+func unit(name string) (rf.Hz, error) {
+	return rf.ParseHz("1" + strings.ToLower(name))
+}
+
+// unitName will return the name of the unit, such as "GHz".
+func unitName(u rf.Hz) (string, error) {
+	switch u {
+	case 1:
+		return "Hz", nil
+	case rf.KHz:
+		return "kHz", nil
+	case rf.MHz:
+		return "MHz", nil
+	case rf.GHz:
+		return "GHz", nil
+	}
+	return "", ErrUnsupported
+}
+
+// parseOptions will parse the "# GHz S MA R 50" option line.
+func (n *Network) parseOptions(line string) error {
+	fields := strings.Fields(strings.TrimPrefix(line, "#"))
+	for i := 0; i < len(fields); i++ {
+		field := strings.ToUpper(fields[i])
+		switch field {
+		case "HZ", "KHZ", "MHZ", "GHZ":
+			u, err := unit(field)
+			if err != nil {
+				return err
+			}
+			n.Unit = u
+		case "S", "Y", "Z", "H", "G":
+			n.Parameter = field
+		case "RI", "MA", "DB":
+			n.Format = Format(field)
+		case "R":
+			if i+1 >= len(fields) {
+				return ErrInvalidFile
+			}
+			r, err := strconv.ParseFloat(fields[i+1], 64)
+			if err != nil {
+				return ErrInvalidFile
+			}
+			n.Reference = []float64{r}
+			i++
+		default:
+			return ErrInvalidFile
+		}
+	}
+	return nil
+}
+
+// Ports will return the number of ports from a Touchstone file name, such as
+// 2 for "antenna.s2p".
+func Ports(filename string) (int, error) {
+	m := regexp.MustCompile(`(?i)\.s([0-9]+)p$`).FindStringSubmatch(filename)
+	if m == nil {
+		return 0, ErrInvalidFile
+	}
+	return strconv.Atoi(m[1])
+}
+
+// ReadFile will read a Touchstone file, using the file name to find the
+// number of ports of a version 1 file.
+func ReadFile(path string) (Network, error) {
+	ports, err := Ports(filepath.Base(path))
+	if err != nil {
+		return Network{}, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return Network{}, err
+	}
+	defer f.Close()
+	return Read(f, ports)
+}
+
+// reader holds the state of Read as it moves through the file.
+type reader struct {
+	network Network
+	order   string
+	matrix  string
+	count   int
+	numbers []float64
+	data    bool
+	done    bool
+}
+
+// keyword will handle a version 2 "[Keyword] value" line.
+func (r *reader) keyword(line string) error {
+	end := strings.Index(line, "]")
+	if end < 0 {
+		return ErrInvalidFile
+	}
+	key := strings.ToLower(line[1:end])
+	value := strings.TrimSpace(line[end+1:])
+	var err error
+	switch key {
+	case "version":
+		if !strings.HasPrefix(value, "2") {
+			return ErrUnsupported
+		}
+		r.network.Version = 2
+	case "number of ports":
+		r.network.Ports, err = strconv.Atoi(value)
+	case "two-port data order":
+		r.order = value
+	case "number of frequencies":
+		r.count, err = strconv.Atoi(value)
+	case "matrix format":
+		r.matrix = strings.ToLower(value)
+	case "reference":
+		r.network.Reference = []float64{}
+		err = r.reference(value)
+	case "network data":
+		r.data = true
+	case "noise data", "end":
+		r.done = true
+	case "mixed-mode order":
+		return ErrUnsupported
+	}
+	if err != nil {
+		return ErrInvalidFile
+	}
+	return nil
+}
+
+// reference will parse reference impedances, which may continue across
+// lines after the [Reference] keyword.
+func (r *reader) reference(line string) error {
+	for _, field := range strings.Fields(line) {
+		z, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return ErrInvalidFile
+		}
+		r.network.Reference = append(r.network.Reference, z)
+	}
+	return nil
+}
+
+// entries will return the (row, column) of each pair of values in a
+// point, in file order.
+func (r *reader) entries() [][2]int {
+	n := r.network.Ports
+	ret := [][2]int{}
+	if n == 2 && (r.network.Version < 2 || r.order == "21_12") {
+		return [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}}
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			switch {
+			case r.matrix == "lower" && j > i:
+				continue
+			case r.matrix == "upper" && j < i:
+				continue
+			}
+			ret = append(ret, [2]int{i, j})
+		}
+	}
+	return ret
+}
+
+// points will convert all the numbers read into Points.
+func (r *reader) points() error {
+	entries := r.entries()
+	size := 1 + 2*len(entries)
+	if len(r.numbers)%size != 0 {
+		// Version 1 2-port files may be followed by noise parameters,
+		// which start when the frequency goes down.
+		if r.network.Version >= 2 || r.network.Ports != 2 {
+			return ErrInvalidFile
+		}
+	}
+
+	n := r.network.Ports
+	for start := 0; start+size <= len(r.numbers); start += size {
+		values := r.numbers[start : start+size]
+		freq := rf.Hz(values[0]) * r.network.Unit
+		if len(r.network.Points) > 0 &&
+			freq <= r.network.Points[len(r.network.Points)-1].Frequency {
+			if r.network.Version < 2 && n == 2 {
+				break
+			}
+			return ErrInvalidFile
+		}
+
+		point := Point{Frequency: freq, Data: make([][]complex128, n)}
+		for i := range point.Data {
+			point.Data[i] = make([]complex128, n)
+		}
+		for k, e := range entries {
+			v := r.network.Format.decode(values[1+2*k], values[2+2*k])
+			point.Data[e[0]][e[1]] = v
+			if r.matrix == "lower" || r.matrix == "upper" {
+				point.Data[e[1]][e[0]] = v
+			}
+		}
+		r.network.Points = append(r.network.Points, point)
+	}
+	if r.network.Version >= 2 && r.count != len(r.network.Points) {
+		return ErrInvalidFile
+	}
+	return nil
+}
+
+// Read will read a Touchstone file. The number of ports is required for
+// version 1 files, since it's only given by the file extension, and is
+// ignored for version 2 files.
+func Read(in io.Reader, ports int) (Network, error) {
+	r := reader{
+		network: Network{
+			Version:   1,
+			Ports:     ports,
+			Parameter: "S",
+			Format:    MA,
+			Unit:      rf.GHz,
+			Reference: []float64{50},
+			Points:    []Point{},
+		},
+	}
+	inReference := false
+	inInformation := false
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() && !r.done {
+		line := scanner.Text()
+		if i := strings.Index(line, "!"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(strings.ToLower(line), "[begin information]"):
+			inInformation = true
+			continue
+		case strings.HasPrefix(strings.ToLower(line), "[end information]"):
+			inInformation = false
+			continue
+		case inInformation:
+			continue
+		case strings.HasPrefix(line, "#"):
+			if err := r.network.parseOptions(line); err != nil {
+				return Network{}, err
+			}
+			continue
+		case strings.HasPrefix(line, "["):
+			inReference = strings.HasPrefix(strings.ToLower(line), "[reference]")
+			if err := r.keyword(line); err != nil {
+				return Network{}, err
+			}
+			continue
+		case inReference && !r.data:
+			if err := r.reference(line); err != nil {
+				return Network{}, err
+			}
+			continue
+		}
+
+		if r.network.Version >= 2 && !r.data {
+			return Network{}, ErrInvalidFile
+		}
+		for _, field := range strings.Fields(line) {
+			v, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return Network{}, ErrInvalidFile
+			}
+			r.numbers = append(r.numbers, v)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Network{}, err
+	}
+
+	if r.network.Ports <= 0 {
+		return Network{}, ErrInvalidFile
+	}
+	if r.network.Version >= 2 && r.network.Ports == 2 && r.order == "" {
+		return Network{}, ErrInvalidFile
+	}
+	if len(r.network.Reference) == 1 && r.network.Ports > 1 {
+		z := r.network.Reference[0]
+		for len(r.network.Reference) < r.network.Ports {
+			r.network.Reference = append(r.network.Reference, z)
+		}
+	}
+	if len(r.network.Reference) != r.network.Ports {
+		return Network{}, ErrInvalidFile
+	}
+	if err := r.points(); err != nil {
+		return Network{}, err
+	}
+	return r.network, nil
+}
+
+// Write will write the Network as a Touchstone file, in the Version,
+// Format and Unit of the Network.
+func (n Network) Write(out io.Writer) error {
+	w := bufio.NewWriter(out)
+	u := n.Unit
+	if u == 0 {
+		u = rf.GHz
+	}
+	format := n.Format
+	if format == "" {
+		format = MA
+	}
+	parameter := n.Parameter
+	if parameter == "" {
+		parameter = "S"
+	}
+	reference := n.Reference
+	if len(reference) == 0 {
+		reference = []float64{50}
+	}
+
+	num := func(v float64) string {
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+
+	name, err := unitName(u)
+	if err != nil {
+		return err
+	}
+
+	v2 := n.Version >= 2
+	if v2 {
+		fmt.Fprintf(w, "[Version] 2.0\n")
+	} else {
+		for _, z := range reference[1:] {
+			if z != reference[0] {
+				return ErrMixedReference
+			}
+		}
+	}
+	fmt.Fprintf(w, "# %s %s %s R %s\n", name, parameter, format, num(reference[0]))
+	if v2 {
+		fmt.Fprintf(w, "[Number of Ports] %d\n", n.Ports)
+		if n.Ports == 2 {
+			fmt.Fprintf(w, "[Two-Port Data Order] 12_21\n")
+		}
+		fmt.Fprintf(w, "[Number of Frequencies] %d\n", len(n.Points))
+		fmt.Fprintf(w, "[Reference]")
+		for i := 0; i < n.Ports; i++ {
+			z := reference[0]
+			if i < len(reference) {
+				z = reference[i]
+			}
+			fmt.Fprintf(w, " %s", num(z))
+		}
+		fmt.Fprintf(w, "\n[Network Data]\n")
+	}
+
+	r := reader{network: Network{Version: n.Version, Ports: n.Ports}, order: "12_21"}
+	entries := r.entries()
+	for _, point := range n.Points {
+		fmt.Fprintf(w, "%s", num(float64(point.Frequency/u)))
+		for k, e := range entries {
+			// Rows of 3 or more port networks start on a new line, and
+			// version 1 files allow at most 4 pairs per line.
+			if n.Ports > 2 && k > 0 && (e[1] == 0 || (!v2 && e[1]%4 == 0)) {
+				fmt.Fprintf(w, "\n")
+			}
+			a, b := format.encode(point.Data[e[0]][e[1]])
+			fmt.Fprintf(w, " %s %s", num(a), num(b))
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	if v2 {
+		fmt.Fprintf(w, "[End]\n")
+	}
+	return w.Flush()
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,144 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package touchstone_test
+
+import (
+	"bytes"
+	"math/cmplx"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/touchstone"
+)
+
+const s1p = `! Antenna return loss
+# MHz S DB R 50
+144 -10 0
+146 -20 90
+148 -10 180
+`
+
+const s2p = `! Amplifier
+# GHz S RI R 50
+1.0 0.1 0.0  2.0 0.0  0.01 0.0  0.2 0.0
+2.0 0.3 0.0  4.0 0.0  0.03 0.0  0.4 0.0
+! noise parameters
+1.0 1.5 0.5 30 0.2
+`
+
+const s2pV2 = `[Version] 2.0
+# GHz S RI R 50
+[Number of Ports] 2
+[Two-Port Data Order] 12_21
+[Number of Frequencies] 1
+[Reference] 50
+75
+[Network Data]
+1.0 0.1 0.0  0.01 0.0  2.0 0.0  0.2 0.0
+[End]
+`
+
+func TestReadV1(t *testing.T) {
+	n, err := touchstone.Read(strings.NewReader(s1p), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, touchstone.DB, n.Format)
+	assert.Equal(t, rf.MHz, n.Unit)
+	assert.Equal(t, 3, len(n.Points))
+	assert.Equal(t, rf.MHz*146, n.Points[1].Frequency)
+	assert.InDelta(t, 0.1, cmplx.Abs(n.Points[1].Value(1, 1)), 1e-9)
+	assert.InDelta(t, 0.1, imag(n.Points[1].Value(1, 1)), 1e-9)
+
+	n, err = touchstone.Read(strings.NewReader(s2p), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(n.Points))
+	assert.Equal(t, []float64{50, 50}, n.Reference)
+	assert.Equal(t, complex(2, 0), n.Points[0].Value(2, 1))
+	assert.Equal(t, complex(0.01, 0), n.Points[0].Value(1, 2))
+
+	s, err := n.At(rf.GHz * 1.5)
+	assert.NoError(t, err)
+	assert.InDelta(t, 3.0, real(s[1][0]), 1e-9)
+
+	_, err = n.At(rf.GHz * 3)
+	assert.Equal(t, touchstone.ErrOutOfRange, err)
+}
+
+func TestReadV2(t *testing.T) {
+	n, err := touchstone.Read(strings.NewReader(s2pV2), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n.Version)
+	assert.Equal(t, 2, n.Ports)
+	assert.Equal(t, []float64{50, 75}, n.Reference)
+	assert.Equal(t, complex(2, 0), n.Points[0].Value(2, 1))
+	assert.Equal(t, complex(0.01, 0), n.Points[0].Value(1, 2))
+
+	var buf bytes.Buffer
+	n.Version = 1
+	assert.Equal(t, touchstone.ErrMixedReference, n.Write(&buf))
+}
+
+func TestRoundTrip(t *testing.T) {
+	n := touchstone.Network{
+		Version:   1,
+		Ports:     3,
+		Parameter: "S",
+		Format:    touchstone.MA,
+		Unit:      rf.MHz,
+		Reference: []float64{50, 50, 50},
+	}
+	for i := 1; i <= 2; i++ {
+		p := touchstone.Point{Frequency: rf.MHz * rf.Hz(i*100)}
+		for row := 0; row < 3; row++ {
+			p.Data = append(p.Data, []complex128{})
+			for col := 0; col < 3; col++ {
+				p.Data[row] = append(p.Data[row], complex(float64(row+1)/10, float64(col+i)/10))
+			}
+		}
+		n.Points = append(n.Points, p)
+	}
+
+	for _, version := range []int{1, 2} {
+		n.Version = version
+		var buf bytes.Buffer
+		assert.NoError(t, n.Write(&buf))
+		again, err := touchstone.Read(&buf, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, n.Points[1].Frequency, again.Points[1].Frequency)
+		for row := 0; row < 3; row++ {
+			for col := 0; col < 3; col++ {
+				assert.InDelta(t, 0, cmplx.Abs(n.Points[1].Data[row][col]-again.Points[1].Data[row][col]), 1e-12)
+			}
+		}
+	}
+}
+
+func TestPorts(t *testing.T) {
+	ports, err := touchstone.Ports("dipole.S2P")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, ports)
+	_, err = touchstone.Ports("dipole.csv")
+	assert.Equal(t, touchstone.ErrInvalidFile, err)
+}
+
+// vim: foldmethod=marker
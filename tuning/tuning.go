@@ -0,0 +1,326 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package tuning
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"hz.tools/rf"
+)
+
+var (
+	// ErrUnreachable will be returned when a target can't be received at
+	// any center frequency, such as when it's wider than half the usable
+	// bandwidth, or outside the tuning limits.
+	ErrUnreachable = fmt.Errorf("tuning: target can not be covered")
+
+	// ErrTooManyTargets will be returned when Optimal is called with more
+	// targets than it can solve.
+	ErrTooManyTargets = fmt.Errorf("tuning: too many targets for an optimal plan")
+)
+
+// MaxOptimalTargets is the largest number of targets Optimal will plan.
+const MaxOptimalTargets = 64
+
+// Radio describes the tuning capabilities of an SDR.
+type Radio struct {
+	// Bandwidth is the usable instantaneous bandwidth, centered on the
+	// tuned frequency.
+	Bandwidth rf.Hz
+
+	// DCMargin is how far, either side of the center frequency, a target
+	// must be to avoid the DC spike. Zero allows targets to cover the
+	// center frequency.
+	DCMargin rf.Hz
+
+	// Limits are the lowest and highest frequencies the radio can be tuned
+	// to. The zero value doesn't limit tuning.
+	Limits rf.Range
+}
+
+// Frequencies will return a target Range for each frequency.
+func Frequencies(freqs ...rf.Hz) []rf.Range {
+	ret := make([]rf.Range, len(freqs))
+	for i, freq := range freqs {
+		ret[i] = rf.Range{freq, freq}
+	}
+	return ret
+}
+
+// Covers will return true if the target can be received with the radio
+// tuned to the center frequency.
+func (r Radio) Covers(center rf.Hz, target rf.Range) bool {
+	if !r.Tunable(center) {
+		return false
+	}
+	half := r.Bandwidth / 2
+	if !(rf.Range{center - half, center + half}).ContainsRange(target) {
+		return false
+	}
+	if r.DCMargin == 0 {
+		return true
+	}
+	return target[1] < center-r.DCMargin || target[0] > center+r.DCMargin
+}
+
+// Tunable will return true if the center frequency is within the Limits.
+func (r Radio) Tunable(center rf.Hz) bool {
+	if r.Limits == (rf.Range{}) {
+		return true
+	}
+	return r.Limits.ContainsFrequency(center)
+}
+
+// Target is a target covered by a Tune.
+type Target struct {
+	// Index of the target in the list passed to the planner.
+	Index int
+
+	// Range of the target.
+	Range rf.Range
+
+	// Offset of the center of the target from the center frequency, which
+	// is where it will be found at baseband.
+	Offset rf.Hz
+}
+
+// Baseband will return the Range of the target at baseband.
+func (t Target) Baseband() rf.Range {
+	tuned := t.Range.Center() - t.Offset
+	return rf.Range{t.Range[0] - tuned, t.Range[1] - tuned}
+}
+
+// Tune is a single center frequency, and the targets it covers.
+type Tune struct {
+	// Center frequency to tune the radio to.
+	Center rf.Hz
+
+	// Targets covered at this Center frequency.
+	Targets []Target
+}
+
+// Plan is a list of Tunes, sorted by center frequency, that cover every
+// target exactly once.
+type Plan []Tune
+
+// candidates will return every center frequency worth considering -- any
+// center frequency can be moved until a target touches the edge of the
+// bandwidth or the DC margin, or a tuning limit, without uncovering
+// anything -- along with which targets each covers.
+func (r Radio) candidates(targets []rf.Range) ([]rf.Hz, [][]bool) {
+	half := r.Bandwidth / 2
+	freqs := []rf.Hz{}
+	if r.Limits != (rf.Range{}) {
+		freqs = append(freqs, r.Limits[0], r.Limits[1])
+	}
+	for _, t := range targets {
+		freqs = append(freqs, t[0]+half, t[1]-half)
+		if r.DCMargin != 0 {
+			freqs = append(freqs, t[0]-r.DCMargin, t[1]+r.DCMargin)
+			// Nudge off the margin, since targets must be strictly
+			// clear of it.
+			freqs = append(freqs, t[0]-r.DCMargin-1, t[1]+r.DCMargin+1)
+		}
+	}
+	sort.Slice(freqs, func(i, j int) bool { return freqs[i] < freqs[j] })
+
+	centers := []rf.Hz{}
+	covers := [][]bool{}
+	for i, freq := range freqs {
+		if i > 0 && freq == freqs[i-1] {
+			continue
+		}
+		covered := make([]bool, len(targets))
+		useful := false
+		for j, t := range targets {
+			covered[j] = r.Covers(freq, t)
+			useful = useful || covered[j]
+		}
+		if useful {
+			centers = append(centers, freq)
+			covers = append(covers, covered)
+		}
+	}
+	return centers, covers
+}
+
+// plan will assign each target to the first of the chosen centers that
+// covers it.
+func (r Radio) plan(targets []rf.Range, centers []rf.Hz) Plan {
+	sort.Slice(centers, func(i, j int) bool { return centers[i] < centers[j] })
+	assigned := make([]bool, len(targets))
+	ret := Plan{}
+	for _, center := range centers {
+		tune := Tune{Center: center}
+		for i, t := range targets {
+			if assigned[i] || !r.Covers(center, t) {
+				continue
+			}
+			assigned[i] = true
+			tune.Targets = append(tune.Targets, Target{
+				Index:  i,
+				Range:  t,
+				Offset: t.Center() - center,
+			})
+		}
+		if len(tune.Targets) > 0 {
+			ret = append(ret, tune)
+		}
+	}
+	return ret
+}
+
+// Greedy will return a Plan that covers every target, by repeatedly picking
+// the center frequency that covers the lowest uncovered target along with
+// the most other uncovered targets. This is fast, but may use more Tunes
+// than Optimal.
+func (r Radio) Greedy(targets []rf.Range) (Plan, error) {
+	centers, covers := r.candidates(targets)
+
+	order := make([]int, len(targets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return targets[order[i]][0] < targets[order[j]][0]
+	})
+
+	uncovered := make([]bool, len(targets))
+	for i := range uncovered {
+		uncovered[i] = true
+	}
+
+	chosen := []rf.Hz{}
+	for _, lowest := range order {
+		if !uncovered[lowest] {
+			continue
+		}
+		best, bestCount := -1, 0
+		for c := range centers {
+			if !covers[c][lowest] {
+				continue
+			}
+			count := 0
+			for t, covered := range covers[c] {
+				if covered && uncovered[t] {
+					count++
+				}
+			}
+			if count > bestCount {
+				best, bestCount = c, count
+			}
+		}
+		if best < 0 {
+			return nil, ErrUnreachable
+		}
+		for t, covered := range covers[best] {
+			if covered {
+				uncovered[t] = false
+			}
+		}
+		chosen = append(chosen, centers[best])
+	}
+	return r.plan(targets, chosen), nil
+}
+
+// Optimal will return a Plan that covers every target with the fewest
+// possible Tunes. This is an exhaustive search, which is only practical for
+// small numbers of targets, and is limited to MaxOptimalTargets.
+func (r Radio) Optimal(targets []rf.Range) (Plan, error) {
+	if len(targets) > MaxOptimalTargets {
+		return nil, ErrTooManyTargets
+	}
+	greedy, err := r.Greedy(targets)
+	if err != nil {
+		return nil, err
+	}
+
+	centers, covers := r.candidates(targets)
+	masks := make([]uint64, len(centers))
+	for c, covered := range covers {
+		for t, ok := range covered {
+			if ok {
+				masks[c] |= 1 << uint(t)
+			}
+		}
+	}
+
+	var (
+		all  = uint64(1)<<uint(len(targets)) - 1
+		best []int
+		// The greedy plan is an upper bound on the number of Tunes.
+		bound  = len(greedy)
+		search func(uncovered uint64, chosen []int)
+	)
+	if len(targets) == 64 {
+		all = ^uint64(0)
+	}
+
+	search = func(uncovered uint64, chosen []int) {
+		if uncovered == 0 {
+			if len(chosen) < bound {
+				bound = len(chosen)
+				best = append([]int{}, chosen...)
+			}
+			return
+		}
+		if len(chosen)+1 >= bound {
+			return
+		}
+
+		// Branch on the uncovered target with the fewest options.
+		target, options := -1, 0
+		for t := 0; t < len(targets); t++ {
+			if uncovered&(1<<uint(t)) == 0 {
+				continue
+			}
+			n := 0
+			for _, mask := range masks {
+				if mask&(1<<uint(t)) != 0 {
+					n++
+				}
+			}
+			if target < 0 || n < options {
+				target, options = t, n
+			}
+		}
+		for c, mask := range masks {
+			if mask&(1<<uint(target)) == 0 || bits.OnesCount64(mask&uncovered) == 0 {
+				continue
+			}
+			search(uncovered&^mask, append(chosen, c))
+		}
+	}
+	search(all, nil)
+
+	if best == nil {
+		return greedy, nil
+	}
+	chosen := []rf.Hz{}
+	for _, c := range best {
+		chosen = append(chosen, centers[c])
+	}
+	return r.plan(targets, chosen), nil
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,106 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package tuning_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/tuning"
+)
+
+// covered will check that every target is covered exactly once, by a Tune
+// that can receive it.
+func covered(t *testing.T, radio tuning.Radio, targets []rf.Range, plan tuning.Plan) {
+	seen := map[int]bool{}
+	for _, tune := range plan {
+		for _, target := range tune.Targets {
+			assert.False(t, seen[target.Index])
+			seen[target.Index] = true
+			assert.True(t, radio.Covers(tune.Center, targets[target.Index]))
+			assert.Equal(t, targets[target.Index].Center()-tune.Center, target.Offset)
+		}
+	}
+	assert.Equal(t, len(targets), len(seen))
+}
+
+func TestGreedy(t *testing.T) {
+	radio := tuning.Radio{Bandwidth: rf.MHz * 2, DCMargin: rf.KHz * 25}
+	targets := tuning.Frequencies(
+		rf.KHz*162400, rf.KHz*162550, rf.KHz*144390,
+		rf.KHz*162475, rf.KHz*145000, rf.KHz*145800,
+	)
+	plan, err := radio.Greedy(targets)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(plan))
+	covered(t, radio, targets, plan)
+
+	for _, tune := range plan {
+		for _, target := range tune.Targets {
+			baseband := target.Baseband()
+			assert.Equal(t, target.Offset, baseband[0])
+			assert.True(t, baseband[0] < -radio.DCMargin || baseband[0] > radio.DCMargin)
+		}
+	}
+}
+
+func TestOptimal(t *testing.T) {
+	// Greedy covers the most targets with its first Tune, which leaves
+	// 101.2MHz and 103.7MHz needing a Tune each.
+	radio := tuning.Radio{Bandwidth: rf.MHz * 2, DCMargin: rf.KHz * 100}
+	targets := tuning.Frequencies(
+		rf.KHz*100300, rf.KHz*101200, rf.KHz*102200,
+		rf.KHz*102300, rf.KHz*103700,
+	)
+	greedy, err := radio.Greedy(targets)
+	assert.NoError(t, err)
+	covered(t, radio, targets, greedy)
+	assert.Equal(t, 3, len(greedy))
+
+	plan, err := radio.Optimal(targets)
+	assert.NoError(t, err)
+	covered(t, radio, targets, plan)
+	assert.Equal(t, 2, len(plan))
+
+	_, err = radio.Optimal(make([]rf.Range, tuning.MaxOptimalTargets+1))
+	assert.Equal(t, tuning.ErrTooManyTargets, err)
+}
+
+func TestLimits(t *testing.T) {
+	radio := tuning.Radio{
+		Bandwidth: rf.MHz * 2,
+		Limits:    rf.Range{rf.MHz * 24, rf.MHz * 1766},
+	}
+	plan, err := radio.Greedy(tuning.Frequencies(rf.MHz * 23.5))
+	assert.NoError(t, err)
+	assert.Equal(t, rf.MHz*24, plan[0].Center)
+
+	_, err = radio.Greedy(tuning.Frequencies(rf.MHz * 22))
+	assert.Equal(t, tuning.ErrUnreachable, err)
+
+	radio = tuning.Radio{Bandwidth: rf.MHz * 2, DCMargin: rf.KHz * 10}
+	_, err = radio.Optimal([]rf.Range{{rf.MHz * 100, rf.MHz * 101.5}})
+	assert.Equal(t, tuning.ErrUnreachable, err)
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,192 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package wellknown
+
+import (
+	"strings"
+
+	"hz.tools/rf"
+	"hz.tools/rf/aviation"
+	"hz.tools/rf/marine"
+)
+
+// Entry is a single well-known signal.
+type Entry struct {
+	// Name of the signal, such as "ADS-B".
+	Name string
+
+	// Description is a short, human readable description of the signal.
+	Description string
+
+	// Frequency is the center frequency of the signal.
+	Frequency rf.Hz
+
+	// Bandwidth is the approximate occupied bandwidth of the signal.
+	Bandwidth rf.Hz
+
+	// Tags used to group related signals, such as "aviation" or
+	// "distress".
+	Tags []string
+}
+
+// Range will return the range of frequencies occupied by the signal.
+func (e Entry) Range() rf.Range {
+	half := e.Bandwidth / 2
+	return rf.Range{-half, half}.Add(e.Frequency)
+}
+
+// HasTag will return true if the Entry has the provided tag.
+func (e Entry) HasTag(tag string) bool {
+	for _, t := range e.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allocation will return the Entry as an rf.Allocation, with a pointer to
+// the Entry set as the Metadata, so the rf.Allocation stays comparable.
+func (e Entry) Allocation() rf.Allocation {
+	return rf.Allocation{
+		Name:     e.Name,
+		Range:    e.Range(),
+		Metadata: &e,
+	}
+}
+
+// EntryOf will return a copy of the Entry of an Allocation returned by this
+// package, and false if the Allocation doesn't have an Entry.
+func EntryOf(a rf.Allocation) (Entry, bool) {
+	e, ok := a.Metadata.(*Entry)
+	if !ok || e == nil {
+		return Entry{}, false
+	}
+	return *e, true
+}
+
+// entry is a helper to create an Entry.
+func entry(name string, freq, bandwidth rf.Hz, description string, tags ...string) Entry {
+	return Entry{
+		Name:        name,
+		Description: description,
+		Frequency:   freq,
+		Bandwidth:   bandwidth,
+		Tags:        tags,
+	}
+}
+
+// band is a helper to create an Entry covering a range of frequencies.
+func band(name string, r rf.Range, description string, tags ...string) Entry {
+	return entry(name, r.Center(), r[1]-r[0], description, tags...)
+}
+
+// Entries are all the well-known signals in the registry.
+var Entries = []Entry{
+	// Aviation
+	entry("ADS-B", rf.MHz*1090, rf.MHz*2, "Mode S Extended Squitter, aircraft position and identification", "aviation", "adsb", "modes"),
+	entry("Mode S Interrogation", rf.MHz*1030, rf.MHz*2, "Secondary surveillance radar interrogation", "aviation", "modes"),
+	entry("UAT", rf.MHz*978, rf.MHz*1, "Universal Access Transceiver ADS-B (US only)", "aviation", "adsb", "us"),
+	entry("ACARS", rf.KHz*131550, rf.KHz*25, "Aircraft Communications Addressing and Reporting System, worldwide primary", "aviation", "acars"),
+	entry("ACARS US Secondary", rf.KHz*130025, rf.KHz*25, "ACARS secondary channel (US)", "aviation", "acars", "us"),
+	entry("ACARS US Tertiary", rf.KHz*129125, rf.KHz*25, "ACARS tertiary channel (US)", "aviation", "acars", "us"),
+	entry("ACARS Europe Primary", rf.KHz*131725, rf.KHz*25, "ACARS primary channel (Europe)", "aviation", "acars", "europe"),
+	entry("ACARS Europe Secondary", rf.KHz*131525, rf.KHz*25, "ACARS secondary channel (Europe)", "aviation", "acars", "europe"),
+
+	// Marine
+	entry("AIS 1", marine.AIS1.Coast, marine.ChannelBandwidth, "Automatic Identification System, channel 87B", "marine", "ais"),
+	entry("AIS 2", marine.AIS2.Coast, marine.ChannelBandwidth, "Automatic Identification System, channel 88B", "marine", "ais"),
+	entry("Marine DSC", marine.Channel70.Ship, marine.ChannelBandwidth, "VHF Digital Selective Calling, channel 70", "marine", "dsc", "distress"),
+
+	// Distress
+	entry("Aviation Emergency", aviation.Emergency.Frequency, rf.KHz*25, "International aeronautical emergency frequency (121.5MHz)", "aviation", "distress"),
+	entry("Military Emergency", rf.MHz*243, rf.KHz*25, "Military aeronautical emergency frequency (243MHz)", "aviation", "military", "distress"),
+	band("Cospas-Sarsat", rf.Range{rf.KHz * 406000, rf.KHz * 406100}, "406MHz distress beacons (EPIRB, ELT, PLB)", "distress", "satellite"),
+	entry("Marine Channel 16", marine.Channel16.Ship, marine.ChannelBandwidth, "Marine VHF distress, safety and calling", "marine", "distress"),
+	entry("Marine MF Distress", rf.KHz*2182, rf.KHz*3, "Marine MF distress and calling (2182kHz)", "marine", "distress"),
+
+	// APRS
+	entry("APRS North America", rf.KHz*144390, rf.KHz*16, "APRS in North America", "aprs", "amateur", "us"),
+	entry("APRS Europe", rf.KHz*144800, rf.KHz*16, "APRS in Europe, Africa and the Middle East (IARU Region 1)", "aprs", "amateur", "europe"),
+	entry("APRS Australia", rf.KHz*145175, rf.KHz*16, "APRS in Australia", "aprs", "amateur", "australia"),
+	entry("APRS New Zealand", rf.KHz*144575, rf.KHz*16, "APRS in New Zealand", "aprs", "amateur"),
+	entry("APRS Japan", rf.KHz*144640, rf.KHz*16, "APRS in Japan", "aprs", "amateur", "japan"),
+	entry("APRS China", rf.KHz*144640, rf.KHz*16, "APRS in China", "aprs", "amateur", "china"),
+	entry("APRS ISS", rf.KHz*145825, rf.KHz*16, "APRS digipeater on the International Space Station", "aprs", "amateur", "satellite"),
+
+	// Weather
+	entry("NOAA Weather Radio WX1", rf.KHz*162550, rf.KHz*25, "NOAA Weather Radio channel 1", "weather", "noaa", "us"),
+	entry("NOAA Weather Radio WX2", rf.KHz*162400, rf.KHz*25, "NOAA Weather Radio channel 2", "weather", "noaa", "us"),
+	entry("NOAA Weather Radio WX3", rf.KHz*162475, rf.KHz*25, "NOAA Weather Radio channel 3", "weather", "noaa", "us"),
+	entry("NOAA Weather Radio WX4", rf.KHz*162425, rf.KHz*25, "NOAA Weather Radio channel 4", "weather", "noaa", "us"),
+	entry("NOAA Weather Radio WX5", rf.KHz*162450, rf.KHz*25, "NOAA Weather Radio channel 5", "weather", "noaa", "us"),
+	entry("NOAA Weather Radio WX6", rf.KHz*162500, rf.KHz*25, "NOAA Weather Radio channel 6", "weather", "noaa", "us"),
+	entry("NOAA Weather Radio WX7", rf.KHz*162525, rf.KHz*25, "NOAA Weather Radio channel 7", "weather", "noaa", "us"),
+
+	// Weather Satellites
+	entry("NOAA 15 APT", rf.KHz*137620, rf.KHz*34, "NOAA 15 Automatic Picture Transmission (decommissioned 2025)", "weather", "satellite", "apt"),
+	entry("NOAA 18 APT", rf.KHz*137912.5, rf.KHz*34, "NOAA 18 Automatic Picture Transmission (decommissioned 2025)", "weather", "satellite", "apt"),
+	entry("NOAA 19 APT", rf.KHz*137100, rf.KHz*34, "NOAA 19 Automatic Picture Transmission (decommissioned 2025)", "weather", "satellite", "apt"),
+	entry("Meteor-M LRPT 137.1", rf.KHz*137100, rf.KHz*150, "Meteor-M Low Rate Picture Transmission", "weather", "satellite", "lrpt"),
+	entry("Meteor-M LRPT 137.9", rf.KHz*137900, rf.KHz*150, "Meteor-M Low Rate Picture Transmission", "weather", "satellite", "lrpt"),
+
+	// Paging
+	band("US Paging", rf.Range{rf.MHz * 929, rf.MHz * 932}, "US narrowband paging (POCSAG and FLEX)", "paging", "pocsag", "flex", "us"),
+	entry("DAPNET", rf.KHz*439987.5, rf.KHz*12.5, "Amateur radio POCSAG paging network (Europe)", "paging", "pocsag", "amateur", "europe"),
+
+	// Time
+	entry("WWV 2.5MHz", rf.KHz*2500, rf.KHz*10, "NIST time and frequency standard, Fort Collins", "time", "wwv", "us"),
+	entry("WWV 5MHz", rf.MHz*5, rf.KHz*10, "NIST time and frequency standard, Fort Collins", "time", "wwv", "us"),
+	entry("WWV 10MHz", rf.MHz*10, rf.KHz*10, "NIST time and frequency standard, Fort Collins", "time", "wwv", "us"),
+	entry("WWV 15MHz", rf.MHz*15, rf.KHz*10, "NIST time and frequency standard, Fort Collins", "time", "wwv", "us"),
+	entry("WWV 20MHz", rf.MHz*20, rf.KHz*10, "NIST time and frequency standard, Fort Collins", "time", "wwv", "us"),
+	entry("WWV 25MHz", rf.MHz*25, rf.KHz*10, "NIST time and frequency standard, Fort Collins", "time", "wwv", "us"),
+	entry("WWVB", rf.KHz*60, rf.Hz(100), "NIST 60kHz time code, Fort Collins", "time", "us"),
+	entry("DCF77", rf.KHz*77.5, rf.Hz(100), "PTB 77.5kHz time code, Mainflingen", "time", "europe"),
+	entry("MSF", rf.KHz*60, rf.Hz(100), "NPL 60kHz time code, Anthorn", "time", "europe"),
+	entry("CHU 3.33MHz", rf.KHz*3330, rf.KHz*3, "NRC time signal, Ottawa", "time", "canada"),
+	entry("CHU 7.85MHz", rf.KHz*7850, rf.KHz*3, "NRC time signal, Ottawa", "time", "canada"),
+	entry("CHU 14.67MHz", rf.KHz*14670, rf.KHz*3, "NRC time signal, Ottawa", "time", "canada"),
+}
+
+// Registry are all the Entries as rf.Allocations, with a pointer to the
+// Entry set as the Metadata.
+var Registry = func() rf.Allocations {
+	ret := rf.Allocations{}
+	for _, e := range Entries {
+		ret = append(ret, e.Allocation())
+	}
+	return ret
+}()
+
+// Tagged will return all the Allocations in the Registry with the provided
+// tag, such as "distress".
+func Tagged(tag string) rf.Allocations {
+	ret := rf.Allocations{}
+	for _, allocation := range Registry {
+		if e, ok := EntryOf(allocation); ok && e.HasTag(tag) {
+			ret = append(ret, allocation)
+		}
+	}
+	return ret
+}
+
+// vim: foldmethod=marker
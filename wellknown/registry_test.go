@@ -0,0 +1,95 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package wellknown_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hz.tools/rf"
+	"hz.tools/rf/wellknown"
+)
+
+func TestTagged(t *testing.T) {
+	distress := wellknown.Tagged("distress")
+	assert.Equal(t, 6, len(distress))
+	assert.Equal(t, 1, len(distress.ContainingFrequency(rf.KHz*121500)))
+	assert.Equal(t, 1, len(distress.ContainingFrequency(rf.KHz*2182)))
+	assert.Equal(t, 1, len(distress.ContainingFrequency(rf.KHz*406050)))
+}
+
+func TestSearch(t *testing.T) {
+	assert.Equal(t, "ADS-B", wellknown.Search("ads-b").First().Name)
+	assert.Equal(t, "DCF77", wellknown.Search("dcf").First().Name)
+	assert.Equal(t, "APRS North America", wellknown.Search("aprs north").First().Name)
+	// Typo
+	assert.Equal(t, "Cospas-Sarsat", wellknown.Search("cospass").First().Name)
+	assert.Equal(t, 0, len(wellknown.Search("")))
+	assert.Equal(t, 0, len(wellknown.Search("zzzzzzzz")))
+}
+
+func TestNear(t *testing.T) {
+	near := wellknown.Near(rf.KHz*144390, 0)
+	assert.Equal(t, 1, len(near))
+	assert.Equal(t, "APRS North America", near.First().Name)
+
+	near = wellknown.Near(rf.KHz*162010, rf.KHz*50)
+	assert.Equal(t, "AIS 2", near.First().Name)
+	assert.Equal(t, "AIS 1", near[1].Name)
+
+	assert.Equal(t, 0, len(wellknown.Near(rf.MHz*3000, rf.MHz)))
+}
+
+func TestEntryOf(t *testing.T) {
+	adsb := wellknown.Search("ads-b").First()
+	e, ok := wellknown.EntryOf(adsb)
+	assert.True(t, ok)
+	assert.Equal(t, rf.MHz*1090, e.Frequency)
+	assert.True(t, e.HasTag("aviation"))
+
+	// Allocations stay comparable, and usable as map keys.
+	assert.True(t, adsb == wellknown.Search("ads-b").First())
+	seen := map[rf.Allocation]bool{}
+	for _, allocation := range wellknown.Registry {
+		seen[allocation] = true
+	}
+	assert.Equal(t, len(wellknown.Registry), len(seen))
+
+	_, ok = wellknown.EntryOf(rf.Allocation{Name: "ADS-B"})
+	assert.False(t, ok)
+}
+
+func TestRegistryWithoutEntry(t *testing.T) {
+	registry := wellknown.Registry
+	defer func() { wellknown.Registry = registry }()
+
+	wellknown.Registry = append(rf.Allocations{{
+		Name:  "ADS-B Local",
+		Range: rf.Range{rf.MHz * 1089, rf.MHz * 1091},
+	}}, registry...)
+
+	assert.Equal(t, "ADS-B", wellknown.Search("ads-b").First().Name)
+	assert.Equal(t, "ADS-B", wellknown.Near(rf.MHz*1090, 0).First().Name)
+	assert.Equal(t, 6, len(wellknown.Tagged("distress")))
+}
+
+// vim: foldmethod=marker
@@ -0,0 +1,165 @@
+// {{{ Copyright (c) Paul R. Tagliamonte <paul@k3xec.com>, 2026
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE. }}}
+
+package wellknown
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"hz.tools/rf"
+)
+
+// matchScore will return how well the query matches the Entry, where lower
+// is better, or -1 if the query doesn't match at all.
+func matchScore(e Entry, query string) int {
+	name := strings.ToLower(e.Name)
+	switch {
+	case name == query:
+		return 0
+	case strings.HasPrefix(name, query):
+		return 1
+	case strings.Contains(name, query):
+		return 2
+	case e.HasTag(query):
+		return 3
+	case strings.Contains(strings.ToLower(e.Description), query):
+		return 4
+	case isSubsequence(query, name):
+		return 5
+	}
+
+	// Allow for a typo or two in any single word of the name.
+	for _, word := range strings.Fields(name) {
+		if len(query) > 3 && levenshtein(query, word) <= 2 {
+			return 6
+		}
+	}
+	return -1
+}
+
+// isSubsequence will return true if every rune of needle appears in
+// haystack, in order -- such as "nwx1" in "noaa weather radio wx1".
+func isSubsequence(needle, haystack string) bool {
+	needle = strings.Replace(needle, " ", "", -1)
+	i := 0
+	runes := []rune(needle)
+	for _, r := range haystack {
+		if i < len(runes) && runes[i] == r {
+			i++
+		}
+	}
+	return i == len(runes)
+}
+
+// levenshtein will compute the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j] + 1
+			if cur[j-1]+1 < cur[j] {
+				cur[j] = cur[j-1] + 1
+			}
+			if prev[j-1]+cost < cur[j] {
+				cur[j] = prev[j-1] + cost
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// Search will fuzzy match the query against the name, tags and description
+// of every Entry in the Registry, and return the matching Allocations, best
+// match first. Allocations in the Registry without an Entry as their
+// Metadata are skipped.
+func Search(query string) rf.Allocations {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return rf.Allocations{}
+	}
+
+	type match struct {
+		allocation rf.Allocation
+		score      int
+	}
+	matches := []match{}
+	for _, allocation := range Registry {
+		e, ok := EntryOf(allocation)
+		if !ok {
+			continue
+		}
+		score := matchScore(e, query)
+		if score < 0 {
+			continue
+		}
+		matches = append(matches, match{allocation: allocation, score: score})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score < matches[j].score
+	})
+
+	ret := rf.Allocations{}
+	for _, m := range matches {
+		ret = append(ret, m.allocation)
+	}
+	return ret
+}
+
+// Near will return all the Allocations in the Registry that are within
+// distance of the provided frequency, closest first. A distance of 0 will
+// only return Allocations whose Range contains the frequency. Allocations
+// in the Registry without an Entry as their Metadata are skipped.
+func Near(freq rf.Hz, distance rf.Hz) rf.Allocations {
+	widened := rf.Allocations{}
+	for _, allocation := range Registry {
+		allocation.Range = rf.Range{
+			allocation.Range[0] - distance,
+			allocation.Range[1] + distance,
+		}
+		widened = append(widened, allocation)
+	}
+
+	ret := rf.Allocations{}
+	for _, allocation := range widened.ContainingFrequency(freq) {
+		if e, ok := EntryOf(allocation); ok {
+			ret = append(ret, e.Allocation())
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return math.Abs(float64(ret[i].Range.Center()-freq)) <
+			math.Abs(float64(ret[j].Range.Center()-freq))
+	})
+	return ret
+}
+
+// vim: foldmethod=marker